@@ -15,9 +15,11 @@
 package main
 
 import (
+	"context"
 	"log/slog"
 	"os"
 
+	"spy_cats_agency/internal/briefing"
 	"spy_cats_agency/internal/config"
 	"spy_cats_agency/internal/handler"
 	"spy_cats_agency/internal/repository/postgres"
@@ -58,26 +60,62 @@ func main() {
 	catRepo := postgres.NewCatRepository(db)
 	missionRepo := postgres.NewMissionRepository(db)
 	targetRepo := postgres.NewTargetRepository(db)
+	skillRepo := postgres.NewSkillRepository(db)
+	payrollRepo := postgres.NewPayrollRepository(db)
+	leaveRepo := postgres.NewLeaveRepository(db)
+	templateRepo := postgres.NewMissionTemplateRepository(db)
+	expenseRepo := postgres.NewExpenseRepository(db)
+	eventRepo := postgres.NewMissionEventRepository(db)
+	transactor := postgres.NewTransactor(db)
 
 	// Initialize the CatAPI client
-	catAPIClient := catapi.NewClient(cfg.CatAPIEndpoint)
+	catAPIClient := catapi.NewClient(cfg.CatAPIEndpoint, cfg.CatAPIKey)
+
+	// Load the briefing templates, overridden by the files in the configured directory
+	briefingRenderer, err := briefing.NewRenderer(cfg.BriefingTemplateDir)
+	if err != nil {
+		appLogger.Error("Failed to load briefing templates", slog.Any("error", err))
+		panic(err)
+	}
 
 	// Initialize services
 	catService := service.NewCatService(catRepo, catAPIClient)
-	missionService := service.NewMissionService(missionRepo, catRepo)
-	targetService := service.NewTargetService(targetRepo, missionRepo)
+	missionService := service.NewMissionService(missionRepo, catRepo, skillRepo, leaveRepo, eventRepo, transactor, appLogger)
+	targetService := service.NewTargetService(targetRepo, missionRepo, eventRepo, transactor)
+	skillService := service.NewSkillService(skillRepo, catRepo)
+	payrollService := service.NewPayrollService(payrollRepo, catRepo, missionRepo, cfg.PayrollMissionBonus)
+	leaveService := service.NewLeaveService(leaveRepo, catRepo, transactor, appLogger)
+	templateService := service.NewMissionTemplateService(templateRepo, missionService)
+	expenseService := service.NewExpenseService(expenseRepo, missionRepo, catRepo)
+	briefingService := service.NewBriefingService(missionService, catService, briefingRenderer)
+
+	// Start background jobs, they stop when the server exits
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	go service.RunPeriodically(jobsCtx, appLogger, "leave status sync", cfg.LeaveSyncInterval, leaveService.SyncStatuses)
+	go service.RunPeriodically(jobsCtx, appLogger, "overdue mission sweep", cfg.OverdueSweepInterval, missionService.FlagOverdueMissions)
 
 	// Initialize handlers
 	catHandler := handler.NewCatHandler(catService)
-	missionHandler := handler.NewMissionHandler(missionService)
+	missionHandler := handler.NewMissionHandler(missionService, briefingService)
 	targetHandler := handler.NewTargetHandler(targetService)
+	skillHandler := handler.NewSkillHandler(skillService)
+	payrollHandler := handler.NewPayrollHandler(payrollService)
+	leaveHandler := handler.NewLeaveHandler(leaveService)
+	templateHandler := handler.NewMissionTemplateHandler(templateService)
+	expenseHandler := handler.NewExpenseHandler(expenseService)
 
 	// Set up router with all routes
 	routerInstance := router.Setup(router.Config{
-		CatHandler:     catHandler,
-		MissionHandler: missionHandler,
-		TargetHandler:  targetHandler,
-		Logger:         appLogger,
+		CatHandler:             catHandler,
+		MissionHandler:         missionHandler,
+		TargetHandler:          targetHandler,
+		SkillHandler:           skillHandler,
+		PayrollHandler:         payrollHandler,
+		LeaveHandler:           leaveHandler,
+		MissionTemplateHandler: templateHandler,
+		ExpenseHandler:         expenseHandler,
+		Logger:                 appLogger,
 	})
 
 	serverAddr := ":" + cfg.ServerPort
@@ -0,0 +1,2 @@
+ALTER TABLE "cats" DROP COLUMN IF EXISTS "archive_reason";
+ALTER TABLE "cats" DROP COLUMN IF EXISTS "archived_at";
@@ -0,0 +1,4 @@
+ALTER TABLE "cats" ADD COLUMN "archived_at" timestamptz;
+ALTER TABLE "cats" ADD COLUMN "archive_reason" text NOT NULL DEFAULT '';
+
+CREATE INDEX ON "cats" ("archived_at");
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "cat_salary_changes";
+DROP FUNCTION IF EXISTS "forbid_modification"();
@@ -0,0 +1,28 @@
+CREATE TABLE "cat_salary_changes" (
+  "id" bigserial PRIMARY KEY,
+  "cat_id" bigint NOT NULL,
+  "old_salary" decimal, -- NULL for a cat's starting salary
+  "new_salary" decimal NOT NULL,
+  "effective_date" date NOT NULL,
+  "reason" text NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "cat_salary_changes" ADD FOREIGN KEY ("cat_id") REFERENCES "cats" ("id");
+
+CREATE INDEX ON "cat_salary_changes" ("cat_id", "effective_date");
+
+-- The ledger is append-only: rows may be inserted but never changed or removed.
+CREATE FUNCTION "forbid_modification"() RETURNS trigger AS $$
+BEGIN
+  RAISE EXCEPTION 'table % is append-only', TG_TABLE_NAME;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER "cat_salary_changes_append_only"
+  BEFORE UPDATE OR DELETE ON "cat_salary_changes"
+  FOR EACH ROW EXECUTE FUNCTION "forbid_modification"();
+
+-- Seed the ledger with the current salary of every existing cat.
+INSERT INTO "cat_salary_changes" ("cat_id", "old_salary", "new_salary", "effective_date", "reason")
+SELECT "id", NULL, "salary", "created_at"::date, 'starting salary' FROM "cats";
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "payroll_entries";
+DROP TABLE IF EXISTS "payroll_runs";
+ALTER TABLE "missions" DROP COLUMN IF EXISTS "completed_at";
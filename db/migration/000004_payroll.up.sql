@@ -0,0 +1,40 @@
+ALTER TABLE "missions" ADD COLUMN "completed_at" timestamptz;
+
+-- Missions completed before this migration were last touched when they were completed.
+UPDATE "missions" SET "completed_at" = "updated_at" WHERE "completed";
+
+CREATE TABLE "payroll_runs" (
+  "id" bigserial PRIMARY KEY,
+  "period_start" date NOT NULL,
+  "period_end" date NOT NULL,
+  "mission_bonus" decimal NOT NULL,
+  "total" decimal NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  CHECK ("period_end" >= "period_start")
+);
+
+CREATE TABLE "payroll_entries" (
+  "id" bigserial PRIMARY KEY,
+  "run_id" bigint NOT NULL,
+  "cat_id" bigint NOT NULL,
+  "cat_name" varchar NOT NULL,
+  "days_worked" int NOT NULL,
+  "base_pay" decimal NOT NULL,
+  "missions_completed" int NOT NULL,
+  "bonus" decimal NOT NULL,
+  "total" decimal NOT NULL
+);
+
+ALTER TABLE "payroll_entries" ADD FOREIGN KEY ("run_id") REFERENCES "payroll_runs" ("id");
+ALTER TABLE "payroll_entries" ADD FOREIGN KEY ("cat_id") REFERENCES "cats" ("id");
+
+CREATE INDEX ON "payroll_entries" ("run_id");
+
+-- Payroll runs are immutable once written.
+CREATE TRIGGER "payroll_runs_append_only"
+  BEFORE UPDATE OR DELETE ON "payroll_runs"
+  FOR EACH ROW EXECUTE FUNCTION "forbid_modification"();
+
+CREATE TRIGGER "payroll_entries_append_only"
+  BEFORE UPDATE OR DELETE ON "payroll_entries"
+  FOR EACH ROW EXECUTE FUNCTION "forbid_modification"();
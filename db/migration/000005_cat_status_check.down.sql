@@ -0,0 +1 @@
+ALTER TABLE "cats" DROP CONSTRAINT IF EXISTS "cats_status_check";
@@ -0,0 +1,2 @@
+ALTER TABLE "cats" ADD CONSTRAINT "cats_status_check"
+  CHECK ("status" IN ('available', 'on_mission', 'on_leave', 'injured', 'suspended', 'retired'));
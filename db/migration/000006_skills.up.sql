@@ -0,0 +1,37 @@
+CREATE TABLE "skills" (
+  "id" bigserial PRIMARY KEY,
+  "name" varchar NOT NULL UNIQUE,
+  "description" text NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "cat_skills" (
+  "cat_id" bigint NOT NULL,
+  "skill_id" bigint NOT NULL,
+  "proficiency" int NOT NULL CHECK ("proficiency" BETWEEN 1 AND 5),
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("cat_id", "skill_id")
+);
+
+CREATE TABLE "mission_required_skills" (
+  "mission_id" bigint NOT NULL,
+  "skill_id" bigint NOT NULL,
+  "min_proficiency" int NOT NULL CHECK ("min_proficiency" BETWEEN 1 AND 5),
+  PRIMARY KEY ("mission_id", "skill_id")
+);
+
+ALTER TABLE "cat_skills" ADD FOREIGN KEY ("cat_id") REFERENCES "cats" ("id") ON DELETE CASCADE;
+ALTER TABLE "cat_skills" ADD FOREIGN KEY ("skill_id") REFERENCES "skills" ("id");
+ALTER TABLE "mission_required_skills" ADD FOREIGN KEY ("mission_id") REFERENCES "missions" ("id") ON DELETE CASCADE;
+ALTER TABLE "mission_required_skills" ADD FOREIGN KEY ("skill_id") REFERENCES "skills" ("id");
+
+CREATE INDEX ON "cat_skills" ("skill_id");
+
+INSERT INTO "skills" ("name", "description") VALUES
+  ('infiltration', 'Entering guarded places unnoticed'),
+  ('surveillance', 'Observing targets over long periods'),
+  ('languages', 'Communicating with local contacts'),
+  ('disguise', 'Passing as an ordinary house cat'),
+  ('lockpicking', 'Opening doors, windows and cat flaps'),
+  ('hacking', 'Getting into computer systems');
@@ -0,0 +1,4 @@
+ALTER TABLE "missions" ADD COLUMN "assigned_at" timestamptz;
+
+-- The assignment time of existing missions is unknown; their creation time is the best estimate.
+UPDATE "missions" SET "assigned_at" = "created_at" WHERE "cat_id" IS NOT NULL;
@@ -0,0 +1,14 @@
+CREATE TABLE "cat_leaves" (
+  "id" bigserial PRIMARY KEY,
+  "cat_id" bigint NOT NULL,
+  "kind" varchar NOT NULL DEFAULT 'leave' CHECK ("kind" IN ('leave', 'training')),
+  "start_date" date NOT NULL,
+  "end_date" date NOT NULL, -- Inclusive
+  "reason" text NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  CHECK ("end_date" >= "start_date")
+);
+
+ALTER TABLE "cat_leaves" ADD FOREIGN KEY ("cat_id") REFERENCES "cats" ("id") ON DELETE CASCADE;
+
+CREATE INDEX ON "cat_leaves" ("cat_id", "start_date", "end_date");
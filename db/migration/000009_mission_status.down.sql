@@ -0,0 +1,9 @@
+ALTER TABLE "missions" ADD COLUMN "completed" boolean NOT NULL DEFAULT false;
+UPDATE "missions" SET "completed" = true WHERE "status" = 'completed';
+
+-- Aborted and failed missions have no equivalent, they become uncompleted again.
+UPDATE "missions" SET "ended_at" = NULL WHERE "status" <> 'completed';
+ALTER TABLE "missions" RENAME COLUMN "ended_at" TO "completed_at";
+
+ALTER TABLE "missions" DROP COLUMN IF EXISTS "started_at";
+ALTER TABLE "missions" DROP COLUMN IF EXISTS "status";
@@ -0,0 +1,20 @@
+ALTER TABLE "missions" ADD COLUMN "status" varchar NOT NULL DEFAULT 'draft';
+ALTER TABLE "missions" ADD COLUMN "started_at" timestamptz;
+ALTER TABLE "missions" RENAME COLUMN "completed_at" TO "ended_at";
+
+-- Missions with a completed target were already being worked on. Their start time is
+-- unknown; the assignment time is the best estimate.
+UPDATE "missions" m SET "status" = CASE
+    WHEN m."completed" THEN 'completed'
+    WHEN m."cat_id" IS NULL THEN 'draft'
+    WHEN EXISTS (SELECT 1 FROM "targets" t WHERE t."mission_id" = m."id" AND t."completed") THEN 'in_progress'
+    ELSE 'assigned'
+  END;
+UPDATE "missions" SET "started_at" = "assigned_at" WHERE "status" IN ('in_progress', 'completed');
+
+ALTER TABLE "missions" ADD CONSTRAINT "missions_status_check"
+  CHECK ("status" IN ('draft', 'assigned', 'in_progress', 'completed', 'aborted', 'failed'));
+
+ALTER TABLE "missions" DROP COLUMN "completed";
+
+CREATE INDEX ON "missions" ("status");
@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS "missions_active_cat_id_key";
+
+-- A cat could only ever have one mission before, so every mission but its active or
+-- latest one loses its cat. This part of the mission history is not restored by
+-- migrating up again.
+UPDATE "missions" SET "cat_id" = NULL
+WHERE "cat_id" IS NOT NULL AND "id" NOT IN (
+  SELECT DISTINCT ON ("cat_id") "id" FROM "missions"
+  WHERE "cat_id" IS NOT NULL
+  ORDER BY "cat_id", ("status" NOT IN ('completed', 'aborted', 'failed')) DESC, "id" DESC
+);
+
+ALTER TABLE "missions" ADD CONSTRAINT "missions_cat_id_key" UNIQUE ("cat_id");
@@ -0,0 +1,12 @@
+-- A cat can have many past missions but only one active mission at a time.
+ALTER TABLE "missions" DROP CONSTRAINT IF EXISTS "missions_cat_id_key";
+CREATE UNIQUE INDEX "missions_active_cat_id_key" ON "missions" ("cat_id")
+  WHERE "status" NOT IN ('completed', 'aborted', 'failed');
+
+-- Cats whose mission ended before this migration were never released.
+UPDATE "cats" SET "status" = 'available', "updated_at" = now()
+WHERE "status" = 'on_mission'
+  AND NOT EXISTS (
+    SELECT 1 FROM "missions" m
+    WHERE m."cat_id" = "cats"."id" AND m."status" NOT IN ('completed', 'aborted', 'failed')
+  );
@@ -0,0 +1,3 @@
+ALTER TABLE "missions" DROP COLUMN IF EXISTS "overdue_at";
+ALTER TABLE "missions" DROP COLUMN IF EXISTS "deadline";
+ALTER TABLE "missions" DROP COLUMN IF EXISTS "priority";
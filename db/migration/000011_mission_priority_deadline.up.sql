@@ -0,0 +1,5 @@
+ALTER TABLE "missions" ADD COLUMN "priority" int NOT NULL DEFAULT 3 CHECK ("priority" BETWEEN 1 AND 5); -- 1 is the most urgent
+ALTER TABLE "missions" ADD COLUMN "deadline" timestamptz;
+ALTER TABLE "missions" ADD COLUMN "overdue_at" timestamptz; -- When the mission was flagged as overdue
+
+CREATE INDEX ON "missions" ("deadline") WHERE "status" NOT IN ('completed', 'aborted', 'failed');
@@ -0,0 +1,19 @@
+CREATE TABLE "mission_templates" (
+  "id" bigserial PRIMARY KEY,
+  "name" varchar NOT NULL UNIQUE,
+  "description" text NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "mission_template_targets" (
+  "id" bigserial PRIMARY KEY,
+  "template_id" bigint NOT NULL,
+  "position" int NOT NULL, -- 1-based order of the target within the template
+  "name" varchar NOT NULL,
+  "country" varchar NOT NULL,
+  "notes" text NOT NULL DEFAULT '',
+  UNIQUE ("template_id", "position")
+);
+
+ALTER TABLE "mission_template_targets" ADD FOREIGN KEY ("template_id") REFERENCES "mission_templates" ("id") ON DELETE CASCADE;
@@ -0,0 +1,3 @@
+ALTER TABLE "missions" ADD COLUMN "cloned_from_id" bigint;
+
+ALTER TABLE "missions" ADD FOREIGN KEY ("cloned_from_id") REFERENCES "missions" ("id") ON DELETE SET NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE "missions" DROP COLUMN IF EXISTS "code";
+ALTER TABLE "missions" DROP COLUMN IF EXISTS "codename";
@@ -0,0 +1,55 @@
+ALTER TABLE "missions" ADD COLUMN "codename" varchar UNIQUE;
+ALTER TABLE "missions" ADD COLUMN "code" varchar UNIQUE; -- Short reference code
+
+-- Give existing missions generated names, using the word list and code alphabet of the
+-- application. Collisions are retried with new random names.
+DO $$
+DECLARE
+  adjectives text[] := ARRAY[
+      'Amber', 'Arctic', 'Ashen', 'Azure', 'Bitter', 'Black', 'Blazing', 'Bold',
+      'Brass', 'Bronze', 'Burning', 'Cobalt', 'Crimson', 'Crystal', 'Dark', 'Distant',
+      'Dusk', 'Emerald', 'Falling', 'Frozen', 'Ghost', 'Gilded', 'Golden', 'Granite',
+      'Grey', 'Hidden', 'Hollow', 'Iron', 'Ivory', 'Jade', 'Lone', 'Lunar',
+      'Midnight', 'Misty', 'Northern', 'Obsidian', 'Pale', 'Quiet', 'Rapid', 'Scarlet',
+      'Shadow', 'Silent', 'Silver', 'Solar', 'Steel', 'Stone', 'Velvet', 'Winter'
+  ];
+  nouns text[] := ARRAY[
+      'Anchor', 'Arrow', 'Badger', 'Beacon', 'Blade', 'Cobra', 'Comet', 'Compass',
+      'Condor', 'Coyote', 'Dagger', 'Eclipse', 'Ember', 'Falcon', 'Fox', 'Gale',
+      'Harbor', 'Hawk', 'Heron', 'Horizon', 'Jaguar', 'Lantern', 'Lynx', 'Mantis',
+      'Meridian', 'Mirage', 'Monsoon', 'Nomad', 'Oracle', 'Owl', 'Panther', 'Phantom',
+      'Pike', 'Prism', 'Quarry', 'Raven', 'Sentinel', 'Serpent', 'Sparrow', 'Spectre',
+      'Tempest', 'Thistle', 'Tiger', 'Torrent', 'Viper', 'Vortex', 'Whisper', 'Wolf'
+  ];
+  alphabet text := '23456789ABCDEFGHJKLMNPQRSTUVWXYZ';
+  mission_id bigint;
+  attempt int;
+  new_codename text;
+  new_code text;
+BEGIN
+  FOR mission_id IN SELECT "id" FROM "missions" WHERE "code" IS NULL ORDER BY "id" LOOP
+    attempt := 0;
+    LOOP
+      attempt := attempt + 1;
+      new_codename := adjectives[1 + floor(random() * array_length(adjectives, 1))::int] || ' ' ||
+                      nouns[1 + floor(random() * array_length(nouns, 1))::int];
+      IF attempt > 3 THEN
+        new_codename := new_codename || ' ' || (10 + floor(random() * 90)::int);
+      END IF;
+      new_code := '';
+      FOR i IN 1..6 LOOP
+        new_code := new_code || substr(alphabet, 1 + floor(random() * length(alphabet))::int, 1);
+      END LOOP;
+
+      BEGIN
+        UPDATE "missions" SET "codename" = new_codename, "code" = new_code WHERE "id" = mission_id;
+        EXIT;
+      EXCEPTION WHEN unique_violation THEN
+        -- Try again with other names
+      END;
+    END LOOP;
+  END LOOP;
+END $$;
+
+ALTER TABLE "missions" ALTER COLUMN "codename" SET NOT NULL;
+ALTER TABLE "missions" ALTER COLUMN "code" SET NOT NULL;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "mission_expenses";
+
+ALTER TABLE "missions" DROP COLUMN IF EXISTS "budget";
@@ -0,0 +1,16 @@
+ALTER TABLE "missions" ADD COLUMN "budget" decimal CHECK ("budget" >= 0); -- NULL if the mission has no budget
+
+CREATE TABLE "mission_expenses" (
+  "id" bigserial PRIMARY KEY,
+  "mission_id" bigint NOT NULL,
+  "amount" decimal NOT NULL CHECK ("amount" > 0),
+  "category" varchar NOT NULL CHECK ("category" IN ('travel', 'lodging', 'equipment', 'informants', 'other')),
+  "spent_on" date NOT NULL,
+  "description" text NOT NULL DEFAULT '',
+  "over_budget" boolean NOT NULL DEFAULT false, -- Recorded with an override despite exceeding the budget
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "mission_expenses" ADD FOREIGN KEY ("mission_id") REFERENCES "missions" ("id") ON DELETE CASCADE;
+
+CREATE INDEX ON "mission_expenses" ("mission_id", "spent_on");
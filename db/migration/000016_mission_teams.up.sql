@@ -0,0 +1,18 @@
+CREATE TABLE "mission_team_members" (
+  "mission_id" bigint NOT NULL,
+  "cat_id" bigint NOT NULL,
+  "role" varchar NOT NULL CHECK ("role" IN ('lead', 'support', 'handler_liaison')),
+  "joined_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("mission_id", "cat_id")
+);
+
+ALTER TABLE "mission_team_members" ADD FOREIGN KEY ("mission_id") REFERENCES "missions" ("id") ON DELETE CASCADE;
+ALTER TABLE "mission_team_members" ADD FOREIGN KEY ("cat_id") REFERENCES "cats" ("id") ON DELETE CASCADE;
+
+-- The lead is mirrored in missions.cat_id, so there is at most one per mission.
+CREATE UNIQUE INDEX "mission_team_members_lead_key" ON "mission_team_members" ("mission_id") WHERE "role" = 'lead';
+CREATE INDEX ON "mission_team_members" ("cat_id");
+
+-- Every assigned cat becomes the lead of its mission's team.
+INSERT INTO "mission_team_members" ("mission_id", "cat_id", "role", "joined_at")
+SELECT "id", "cat_id", 'lead', COALESCE("assigned_at", "created_at") FROM "missions" WHERE "cat_id" IS NOT NULL;
@@ -0,0 +1,12 @@
+CREATE TABLE "mission_dependencies" (
+  "mission_id" bigint NOT NULL,
+  "prerequisite_id" bigint NOT NULL, -- Must be completed before the mission can start
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("mission_id", "prerequisite_id"),
+  CHECK ("mission_id" <> "prerequisite_id")
+);
+
+ALTER TABLE "mission_dependencies" ADD FOREIGN KEY ("mission_id") REFERENCES "missions" ("id") ON DELETE CASCADE;
+ALTER TABLE "mission_dependencies" ADD FOREIGN KEY ("prerequisite_id") REFERENCES "missions" ("id") ON DELETE CASCADE;
+
+CREATE INDEX ON "mission_dependencies" ("prerequisite_id");
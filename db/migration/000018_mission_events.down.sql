@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "mission_events";
@@ -0,0 +1,21 @@
+CREATE TABLE "mission_events" (
+  "id" bigserial PRIMARY KEY,
+  "mission_id" bigint NOT NULL,
+  "kind" varchar NOT NULL,
+  "actor" varchar NOT NULL, -- Who made the change, "system" for background jobs
+  "payload" jsonb NOT NULL DEFAULT '{}',
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "mission_events" ADD FOREIGN KEY ("mission_id") REFERENCES "missions" ("id") ON DELETE CASCADE;
+
+CREATE INDEX ON "mission_events" ("mission_id", "created_at");
+
+-- Start the timelines of existing missions with what is known about their past.
+INSERT INTO "mission_events" ("mission_id", "kind", "actor", "payload", "created_at")
+SELECT "id", 'mission_created', 'system', jsonb_build_object('codename', "codename", 'code', "code", 'priority', "priority"), "created_at"
+FROM "missions";
+
+INSERT INTO "mission_events" ("mission_id", "kind", "actor", "payload", "created_at")
+SELECT "id", 'mission_completed', 'system', '{}', "ended_at"
+FROM "missions" WHERE "status" = 'completed' AND "ended_at" IS NOT NULL;
@@ -0,0 +1 @@
+ALTER TABLE "payroll_runs" DROP CONSTRAINT IF EXISTS "payroll_runs_period_excl";
@@ -0,0 +1,4 @@
+-- Payroll runs are immutable, so two runs must never pay the same day, even when they
+-- are created concurrently.
+ALTER TABLE "payroll_runs" ADD CONSTRAINT "payroll_runs_period_excl"
+  EXCLUDE USING gist (daterange("period_start", "period_end", '[]') WITH &&);
@@ -0,0 +1,10 @@
+-- The record of cats that left a team is lost.
+DELETE FROM "mission_team_members" WHERE "left_at" IS NOT NULL;
+
+DROP INDEX IF EXISTS "mission_team_members_lead_key";
+CREATE UNIQUE INDEX "mission_team_members_lead_key" ON "mission_team_members" ("mission_id") WHERE "role" = 'lead';
+
+DROP INDEX IF EXISTS "mission_team_members_member_key";
+ALTER TABLE "mission_team_members" DROP COLUMN IF EXISTS "id";
+ALTER TABLE "mission_team_members" ADD PRIMARY KEY ("mission_id", "cat_id");
+ALTER TABLE "mission_team_members" DROP COLUMN IF EXISTS "left_at";
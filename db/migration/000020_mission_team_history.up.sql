@@ -0,0 +1,10 @@
+-- Cats that leave a mission team stay on record with the time they left, so a cat can
+-- also rejoin the team of a mission it left.
+ALTER TABLE "mission_team_members" ADD COLUMN "left_at" timestamptz;
+ALTER TABLE "mission_team_members" DROP CONSTRAINT "mission_team_members_pkey";
+ALTER TABLE "mission_team_members" ADD COLUMN "id" bigserial PRIMARY KEY;
+
+CREATE UNIQUE INDEX "mission_team_members_member_key" ON "mission_team_members" ("mission_id", "cat_id") WHERE "left_at" IS NULL;
+
+DROP INDEX "mission_team_members_lead_key";
+CREATE UNIQUE INDEX "mission_team_members_lead_key" ON "mission_team_members" ("mission_id") WHERE "role" = 'lead' AND "left_at" IS NULL;
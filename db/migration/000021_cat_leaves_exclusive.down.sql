@@ -0,0 +1 @@
+ALTER TABLE "cat_leaves" DROP CONSTRAINT IF EXISTS "cat_leaves_period_excl";
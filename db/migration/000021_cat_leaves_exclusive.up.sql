@@ -0,0 +1,6 @@
+-- Leave periods of the same cat must never overlap, even when they are scheduled
+-- concurrently. btree_gist lets the cat ID take part in the GiST exclusion.
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+ALTER TABLE "cat_leaves" ADD CONSTRAINT "cat_leaves_period_excl"
+  EXCLUDE USING gist ("cat_id" WITH =, daterange("start_date", "end_date", '[]') WITH &&);
@@ -0,0 +1,17 @@
+-- Every retirement of a cat, so that payroll doesn't pay the days between a retirement
+-- and a later restore.
+CREATE TABLE "cat_retirements" (
+  "id" bigserial PRIMARY KEY,
+  "cat_id" bigint NOT NULL,
+  "retired_at" timestamptz NOT NULL,
+  "restored_at" timestamptz, -- Null while the cat is retired
+  "reason" text NOT NULL DEFAULT ''
+);
+
+ALTER TABLE "cat_retirements" ADD FOREIGN KEY ("cat_id") REFERENCES "cats" ("id") ON DELETE CASCADE;
+
+CREATE UNIQUE INDEX "cat_retirements_open_key" ON "cat_retirements" ("cat_id") WHERE "restored_at" IS NULL;
+
+-- Only current retirements are known, earlier ones were cleared on restore.
+INSERT INTO "cat_retirements" ("cat_id", "retired_at", "reason")
+SELECT "id", "archived_at", "archive_reason" FROM "cats" WHERE "archived_at" IS NOT NULL;
@@ -26,22 +26,109 @@ const docTemplate = `{
     "paths": {
         "/cats": {
             "get": {
-                "description": "Retrieves a list of all spy cats in the system.",
+                "description": "Retrieves a page of spy cats. Pass the returned next_cursor as the cursor parameter to fetch the following page.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "cats"
                 ],
-                "summary": "List all spy cats",
+                "summary": "List spy cats",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by breed",
+                        "name": "breed",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by a case-insensitive name substring",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Minimum years of experience",
+                        "name": "min_experience",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum years of experience",
+                        "name": "max_experience",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum salary",
+                        "name": "min_salary",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum salary",
+                        "name": "max_salary",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include retired cats",
+                        "name": "include_archived",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "created_at",
+                            "name",
+                            "years_of_experience",
+                            "salary"
+                        ],
+                        "type": "string",
+                        "description": "Sort key",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pagination cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 50, max 200)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/domain.Cat"
-                            }
+                            "$ref": "#/definitions/domain.CatPage"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
                     "500": {
@@ -97,30 +184,49 @@ const docTemplate = `{
                 }
             }
         },
-        "/cats/{id}": {
-            "get": {
-                "description": "Retrieves details of a specific spy cat.",
+        "/cats/import": {
+            "post": {
+                "description": "Creates cats from a JSON array of cats or a CSV file with a header row of name, years_of_experience, breed and salary. Each row is validated like a single cat. In atomic mode nothing is created unless every row is valid; in best_effort mode the valid rows are created. Responds 422 if no cat was created.",
+                "consumes": [
+                    "application/json",
+                    "text/csv"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "cats"
                 ],
-                "summary": "Get a spy cat by ID",
+                "summary": "Import spy cats",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Cat ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Cats to create",
+                        "name": "cats",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.CreateCatRequest"
+                            }
+                        }
+                    },
+                    {
+                        "enum": [
+                            "atomic",
+                            "best_effort"
+                        ],
+                        "type": "string",
+                        "description": "Import mode (default atomic)",
+                        "name": "mode",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/domain.Cat"
+                            "$ref": "#/definitions/domain.CatImportReport"
                         }
                     },
                     "400": {
@@ -129,32 +235,60 @@ const docTemplate = `{
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/domain.CatImportReport"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Removes a spy cat from the system.",
+            }
+        },
+        "/cats/leaderboard": {
+            "get": {
+                "description": "Ranks active spy cats by their performance statistics. Cats with the shortest average time to completion rank first when sorting by avg_completion_hours.",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "cats"
                 ],
-                "summary": "Delete a spy cat",
+                "summary": "Get the spy cat leaderboard",
                 "parameters": [
+                    {
+                        "enum": [
+                            "missions_completed",
+                            "targets_completed",
+                            "missions_assigned",
+                            "avg_completion_hours"
+                        ],
+                        "type": "string",
+                        "description": "Ranking key (default missions_completed)",
+                        "name": "sort",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
-                        "description": "Cat ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Number of cats (default 50, max 200)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.CatStats"
+                            }
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -171,19 +305,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/cats/{id}/salary": {
-            "patch": {
-                "description": "Updates the salary of a specific spy cat.",
-                "consumes": [
-                    "application/json"
-                ],
+        "/cats/{id}": {
+            "get": {
+                "description": "Retrieves details of a specific spy cat.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "cats"
                 ],
-                "summary": "Update a spy cat's salary",
+                "summary": "Get a spy cat by ID",
                 "parameters": [
                     {
                         "type": "integer",
@@ -191,15 +322,6 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "New salary",
-                        "name": "salary",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.UpdateCatSalaryRequest"
-                        }
                     }
                 ],
                 "responses": {
@@ -222,26 +344,36 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/missions": {
-            "get": {
-                "description": "Retrieves a list of all missions.",
-                "produces": [
-                    "application/json"
-                ],
+            },
+            "delete": {
+                "description": "Retires and archives a spy cat. Retired cats are hidden from listings by default and can be restored. Cats on an active mission cannot be retired.",
                 "tags": [
-                    "missions"
+                    "cats"
+                ],
+                "summary": "Retire a spy cat",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Cat ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reason for the retirement",
+                        "name": "reason",
+                        "in": "query"
+                    }
                 ],
-                "summary": "List all missions",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/domain.Mission"
-                            }
+                            "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
                     "500": {
@@ -252,8 +384,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
-                "description": "Creates a new mission with 1 to 3 targets. Optionally assign a cat during creation.",
+            "patch": {
+                "description": "Updates any of a spy cat's name, years of experience, breed and salary. Omitted fields are left unchanged; a new breed must be valid according to TheCatAPI.",
                 "consumes": [
                     "application/json"
                 ],
@@ -261,25 +393,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "missions"
+                    "cats"
                 ],
-                "summary": "Create a new mission",
+                "summary": "Update a spy cat",
                 "parameters": [
                     {
-                        "description": "Mission to create",
-                        "name": "mission",
+                        "type": "integer",
+                        "description": "Cat ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "cat",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.CreateMissionRequest"
+                            "$ref": "#/definitions/handler.UpdateCatRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.Mission"
+                            "$ref": "#/definitions/domain.Cat"
                         }
                     },
                     "400": {
@@ -297,30 +436,42 @@ const docTemplate = `{
                 }
             }
         },
-        "/missions/{id}": {
+        "/cats/{id}/availability": {
             "get": {
-                "description": "Retrieves details of a specific mission, including its targets.",
+                "description": "Retrieves the leave periods of a spy cat within a date range and whether it can take a mission today.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "missions"
+                    "cats"
                 ],
-                "summary": "Get a mission by ID",
+                "summary": "Get a spy cat's availability",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Mission ID",
+                        "description": "Cat ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start of the range, YYYY-MM-DD (default today)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End of the range, YYYY-MM-DD (default 30 days after from)",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.Mission"
+                            "$ref": "#/definitions/domain.CatAvailability"
                         }
                     },
                     "400": {
@@ -329,32 +480,40 @@ const docTemplate = `{
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Deletes a mission if it is not assigned to a cat.",
+            }
+        },
+        "/cats/{id}/dossier": {
+            "get": {
+                "description": "Retrieves a spy cat together with its breed details and a picture of its breed from TheCatAPI.",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "missions"
+                    "cats"
                 ],
-                "summary": "Delete a mission",
+                "summary": "Get a spy cat's dossier",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Mission ID",
+                        "description": "Cat ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.CatDossier"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -369,9 +528,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "Updates mission details like assigned cat.",
+            }
+        },
+        "/cats/{id}/leave": {
+            "post": {
+                "description": "Schedules a leave or training period. The cat is put on leave while the period lasts and becomes available again when it ends.",
                 "consumes": [
                     "application/json"
                 ],
@@ -379,32 +540,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "missions"
+                    "cats"
                 ],
-                "summary": "Update a mission",
+                "summary": "Schedule leave for a spy cat",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Mission ID",
+                        "description": "Cat ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Mission updates",
-                        "name": "mission",
+                        "description": "Leave period",
+                        "name": "leave",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.UpdateMissionRequest"
+                            "$ref": "#/definitions/handler.CreateLeaveRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/domain.Mission"
+                            "$ref": "#/definitions/domain.Leave"
                         }
                     },
                     "400": {
@@ -413,12 +574,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/handler.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -428,42 +583,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/missions/{id}/assign-cat": {
-            "patch": {
-                "description": "Assigns an available spy cat to an existing mission.",
-                "consumes": [
-                    "application/json"
-                ],
+        "/cats/{id}/missions": {
+            "get": {
+                "description": "Retrieves the active and past missions a spy cat was assigned to, latest assignment first.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "missions"
+                    "cats"
                 ],
-                "summary": "Assign a cat to a mission",
+                "summary": "List a spy cat's missions",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Mission ID",
+                        "description": "Cat ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Cat to assign",
-                        "name": "cat",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.AssignCatRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.MessageResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.Mission"
+                            }
                         }
                     },
                     "400": {
@@ -481,34 +627,75 @@ const docTemplate = `{
                 }
             }
         },
-        "/missions/{id}/complete": {
-            "patch": {
-                "description": "Manually marks a mission as completed or uncompleted.",
-                "consumes": [
-                    "application/json"
+        "/cats/{id}/restore": {
+            "post": {
+                "description": "Restores a retired spy cat and makes it available for missions again.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cats"
+                ],
+                "summary": "Restore a retired spy cat",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Cat ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Cat"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cats/{id}/salary": {
+            "patch": {
+                "description": "Updates the salary of a specific spy cat and records the change in its salary history.",
+                "consumes": [
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "missions"
+                    "cats"
                 ],
-                "summary": "Complete/uncomplete a mission",
+                "summary": "Update a spy cat's salary",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Mission ID",
+                        "description": "Cat ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Completion status",
-                        "name": "completion",
+                        "description": "New salary",
+                        "name": "salary",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.CompleteMissionRequest"
+                            "$ref": "#/definitions/handler.UpdateCatSalaryRequest"
                         }
                     }
                 ],
@@ -516,7 +703,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.Mission"
+                            "$ref": "#/definitions/domain.Cat"
                         }
                     },
                     "400": {
@@ -530,6 +717,44 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
+                    }
+                }
+            }
+        },
+        "/cats/{id}/salary-history": {
+            "get": {
+                "description": "Retrieves every salary change of a spy cat, starting with its starting salary.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cats"
+                ],
+                "summary": "Get a spy cat's salary history",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Cat ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.SalaryChange"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
                     },
                     "500": {
                         "description": "Internal Server Error",
@@ -540,9 +765,53 @@ const docTemplate = `{
                 }
             }
         },
-        "/missions/{id}/targets": {
-            "post": {
-                "description": "Adds a new target to an existing, non-completed mission.",
+        "/cats/{id}/skills": {
+            "get": {
+                "description": "Retrieves the skills of a spy cat with their proficiency levels.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cats"
+                ],
+                "summary": "List a spy cat's skills",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Cat ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.CatSkill"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cats/{id}/skills/{skillId}": {
+            "put": {
+                "description": "Gives a spy cat a skill from the catalog, or changes the proficiency of a skill it already has.",
                 "consumes": [
                     "application/json"
                 ],
@@ -550,32 +819,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "missions"
+                    "cats"
                 ],
-                "summary": "Add a target to a mission",
+                "summary": "Set a spy cat's skill",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Mission ID",
+                        "description": "Cat ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Target to add",
-                        "name": "target",
+                        "type": "integer",
+                        "description": "Skill ID",
+                        "name": "skillId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Proficiency level",
+                        "name": "skill",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.CreateTargetRequest"
+                            "$ref": "#/definitions/handler.SetCatSkillRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.Target"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.CatSkill"
+                            }
                         }
                     },
                     "400": {
@@ -591,22 +870,27 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/targets/{id}": {
+            },
             "delete": {
-                "description": "Deletes a target from a mission if it is not yet completed.",
+                "description": "Removes a skill from a spy cat.",
                 "tags": [
-                    "targets"
+                    "cats"
                 ],
-                "summary": "Delete a target",
+                "summary": "Remove a spy cat's skill",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Target ID",
+                        "description": "Cat ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Skill ID",
+                        "name": "skillId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -628,17 +912,20 @@ const docTemplate = `{
                 }
             }
         },
-        "/targets/{id}/complete": {
-            "patch": {
-                "description": "Marks a target as complete. If all targets in the mission are complete, the mission is also marked as complete.",
+        "/cats/{id}/stats": {
+            "get": {
+                "description": "Retrieves the missions assigned to and completed by a spy cat, its completed targets, the average time from assignment to completion and the countries it operated in.",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "targets"
+                    "cats"
                 ],
-                "summary": "Complete a target",
+                "summary": "Get a spy cat's statistics",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Target ID",
+                        "description": "Cat ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -648,7 +935,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.Target"
+                            "$ref": "#/definitions/domain.CatStats"
                         }
                     },
                     "400": {
@@ -666,9 +953,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/targets/{id}/notes": {
-            "patch": {
-                "description": "Updates the notes for a specific target if it is not completed.",
+        "/cats/{id}/status": {
+            "post": {
+                "description": "Moves a spy cat to a new status, such as on_leave, injured or suspended. Transitions that the status rules don't allow are rejected; mission assignment and retirement have their own endpoints.",
                 "consumes": [
                     "application/json"
                 ],
@@ -676,24 +963,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "targets"
+                    "cats"
                 ],
-                "summary": "Update target notes",
+                "summary": "Change a spy cat's status",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Target ID",
+                        "description": "Cat ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "New notes",
-                        "name": "notes",
+                        "description": "New status",
+                        "name": "status",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.UpdateTargetNotesRequest"
+                            "$ref": "#/definitions/handler.ChangeCatStatusRequest"
                         }
                     }
                 ],
@@ -701,7 +988,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.Target"
+                            "$ref": "#/definitions/domain.Cat"
                         }
                     },
                     "400": {
@@ -718,70 +1005,2684 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "domain.Cat": {
-            "type": "object",
-            "properties": {
-                "breed": {
-                    "type": "string"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "salary": {
-                    "type": "number"
-                },
-                "status": {
-                    "type": "string"
-                },
-                "updated_at": {
-                    "type": "string"
-                },
-                "years_of_experience": {
-                    "type": "integer"
-                }
-            }
-        },
-        "domain.Mission": {
-            "type": "object",
-            "properties": {
-                "cat_id": {
-                    "description": "Nullable, as a mission can be unassigned",
-                    "type": "integer"
-                },
-                "completed": {
-                    "type": "boolean"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "targets": {
-                    "description": "Skip DB mapping for nested slice",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/domain.Target"
-                    }
-                },
-                "updated_at": {
-                    "type": "string"
-                }
-            }
         },
-        "domain.Target": {
-            "type": "object",
-            "properties": {
-                "completed": {
+        "/mission-templates": {
+            "get": {
+                "description": "Retrieves all mission templates without their targets, by name.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "mission-templates"
+                ],
+                "summary": "List mission templates",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.MissionTemplate"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Saves a named template holding 1 to 3 target skeletons that missions can be created from.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "mission-templates"
+                ],
+                "summary": "Create a mission template",
+                "parameters": [
+                    {
+                        "description": "Template to create",
+                        "name": "template",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.MissionTemplateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/domain.MissionTemplate"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/mission-templates/{id}": {
+            "get": {
+                "description": "Retrieves a mission template including its target skeletons.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "mission-templates"
+                ],
+                "summary": "Get a mission template by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.MissionTemplate"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replaces the name, description and target skeletons of a mission template. Missions already created from it are not affected.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "mission-templates"
+                ],
+                "summary": "Replace a mission template",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New template content",
+                        "name": "template",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.MissionTemplateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.MissionTemplate"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a mission template. Missions already created from it are kept.",
+                "tags": [
+                    "mission-templates"
+                ],
+                "summary": "Delete a mission template",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/missions": {
+            "get": {
+                "description": "Retrieves a page of missions, newest first unless another sort order is requested. Missions without a deadline come after those with one. Pass the returned next_cursor as the cursor parameter to fetch the following page.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "missions"
+                ],
+                "summary": "List missions",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Filter by completion",
+                        "name": "completed",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by assigned cat",
+                        "name": "cat_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by the country of any target, case-insensitive",
+                        "name": "country",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created on or after this date, YYYY-MM-DD",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created on or before this date, YYYY-MM-DD",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "targets"
+                        ],
+                        "type": "string",
+                        "description": "Embed related data",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "created_at",
+                            "priority",
+                            "deadline"
+                        ],
+                        "type": "string",
+                        "description": "Sort key (default created_at)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order (default desc for created_at, asc otherwise)",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 50, max 200)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.MissionPage"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a new mission with 1 to 3 targets and optional skill requirements. Optionally assign a cat during creation; it must have the required skills.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "missions"
+                ],
+                "summary": "Create a new mission",
+                "parameters": [
+                    {
+                        "description": "Mission to create",
+                        "name": "mission",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateMissionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Mission"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/missions/by-code/{code}": {
+            "get": {
+                "description": "Retrieves details of a specific mission by its short reference code, ignoring case.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "missions"
+                ],
+                "summary": "Get a mission by its reference code",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Mission reference code",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Mission"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/missions/from-template/{id}": {
+            "post": {
+                "description": "Creates a mission with the targets of a template. Fields of individual targets as well as the cat, priority, deadline and required skills can be overridden. The mission is validated like any other new mission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "missions"
+                ],
+                "summary": "Create a mission from a template",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Overrides",
+                        "name": "overrides",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateMissionFromTemplateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Mission"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/missions/overdue": {
+            "get": {
+                "description": "Retrieves the missions that are not completed, aborted or failed although their deadline has passed, most overdue first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "missions"
+                ],
+                "summary": "List overdue missions",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.Mission"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/missions/{id}": {
+            "get": {
+                "description": "Retrieves details of a specific mission, including its targets.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "missions"
+                ],
+                "summary": "Get a mission by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Mission ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Mission"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a mission if it is not assigned to a cat.",
+                "tags": [
+                    "missions"
+                ],
+                "summary": "Delete a mission",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Mission ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/missions/{id}/assign-cat": {
+            "patch": {
+                "description": "Assigns an available spy cat to an existing mission, releasing the previously assigned cat. The cat must have the skills the mission requires.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "missions"
+                ],
+                "summary": "Assign a cat to a mission",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Mission ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Cat to assign",
+                        "name": "cat",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.AssignCatRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/missions/{id}/briefing": {
+            "get": {
+                "description": "Renders a briefing document from the mission, its targets and notes, its team and the assigned cat's profile and breed details.",
+                "produces": [
+                    "text/markdown",
+                    "text/html"
+                ],
+                "tags": [
+                    "missions"
+                ],
+                "summary": "Get a mission briefing",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Mission ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "markdown",
+                            "html"
+                        ],
+                        "type": "string",
+                        "default": "markdown",
+                        "description": "Document format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/missions/{id}/budget": {
+            "put": {
+                "description": "Replaces the budget of a mission. A null budget removes the limit. Expenses that would exceed the budget are rejected unless they are recorded with an override.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "missions"
+                ],
+                "summary": "Set a mission's budget",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Mission ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New budget",
+                        "name": "budget",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.SetBudgetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Mission"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/missions/{id}/candidates": {
+            "get": {
+                "description": "Ranks the available cats that meet a mission's skill requirements. The score combines years of experience, targets already completed in the mission's countries and current workload, and each candidate comes with an explanation of its score.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "missions"
+                ],
+                "summary": "List candidate cats for a mission",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Mission ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of candidates (default 10)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.MissionCandidate"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/missions/{id}/cat": {
+            "patch": {
+                "description": "Swaps the cat assigned to a mission, or unassigns it when cat_id is null. The outgoing cat becomes available again and the incoming cat goes on the mission as the team lead; unassigning the cat releases the whole team. Missions that have ended cannot be reassigned, and missions in progress cannot be left without a cat.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "missions"
+                ],
+                "summary": "Reassign or unassign a mission's cat",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Mission ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Cat to assign",
+                        "name": "cat",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.UpdateMissionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Mission"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/missions/{id}/clone": {
+            "post": {
+                "description": "Copies a mission's targets, priority and required skills into a new unassigned draft mission that references the original. Target completion is cleared and notes are reset unless keep_notes is set. The deadline is not copied.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "missions"
+                ],
+                "summary": "Clone a mission",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Mission ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Clone options",
+                        "name": "options",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CloneMissionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Mission"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/missions/{id}/complete": {
+            "patch": {
+                "description": "Manually marks a mission in progress as completed. Completed missions cannot be reopened.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "missions"
+                ],
+                "summary": "Complete a mission",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Mission ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Completion status",
+                        "name": "completion",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CompleteMissionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Mission"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/missions/{id}/dependencies": {
+            "get": {
+                "description": "Retrieves the missions a mission transitively waits for (upstream) and the missions transitively waiting for it (downstream), with every link between them.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "missions"
+                ],
+                "summary": "Get a mission's dependencies",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Mission ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.MissionDependencyGraph"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Makes a mission wait for a prerequisite mission: it can't be assigned or started until the prerequisite is completed. Missions that have started can't get new prerequisites, and links that would create a cycle are rejected.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "missions"
+                ],
+                "summary": "Add a prerequisite to a mission",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Mission ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Prerequisite mission",
+                        "name": "dependency",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.AddDependencyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/domain.MissionDependencyGraph"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/missions/{id}/dependencies/{prerequisiteId}": {
+            "delete": {
+                "description": "Removes a prerequisite, so that the mission no longer waits for it.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "missions"
+                ],
+                "summary": "Remove a prerequisite from a mission",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Mission ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Prerequisite mission ID",
+                        "name": "prerequisiteId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.MissionDependencyGraph"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/missions/{id}/expenses": {
+            "get": {
+                "description": "Retrieves the expense ledger of a mission in the order the money was spent.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "missions"
+                ],
+                "summary": "List a mission's expenses",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Mission ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.Expense"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds an expense to the ledger of a mission. An expense that would exceed the remaining budget is rejected unless override is set, in which case it is flagged as over budget.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "missions"
+                ],
+                "summary": "Record a mission expense",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Mission ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Expense to record",
+                        "name": "expense",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateExpenseRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Expense"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/missions/{id}/finance": {
+            "get": {
+                "description": "Shows the money spent on a mission against its budget, broken down by category, and the salary of every team member prorated over the days it was on the team while the mission was running.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "missions"
+                ],
+                "summary": "Get a mission's finances",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Mission ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.MissionFinance"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/missions/{id}/required-skills": {
+            "put": {
+                "description": "Replaces the skills a mission requires. An already assigned cat must meet the new requirements.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "missions"
+                ],
+                "summary": "Set a mission's required skills",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Mission ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Required skills",
+                        "name": "requirements",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.SetRequiredSkillsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Mission"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/missions/{id}/status": {
+            "post": {
+                "description": "Moves a mission along its lifecycle, e.g. from in_progress to completed, aborted or failed. Transitions that the status rules don't allow are rejected; missions become assigned or return to draft through their cat.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "missions"
+                ],
+                "summary": "Change a mission's status",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Mission ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New status",
+                        "name": "status",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.ChangeMissionStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Mission"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/missions/{id}/targets": {
+            "post": {
+                "description": "Adds a new target to an existing, non-completed mission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "missions"
+                ],
+                "summary": "Add a target to a mission",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Mission ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Target to add",
+                        "name": "target",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateTargetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Target"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/missions/{id}/team": {
+            "post": {
+                "description": "Puts a cat on the team of a mission in the given role. Adding a lead reassigns the mission's cat; support cats and handler liaisons can only join a mission that has a lead. The cat goes on the mission until it ends or the cat is removed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "missions"
+                ],
+                "summary": "Add a cat to a mission team",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Mission ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Team member",
+                        "name": "member",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.AddTeamMemberRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Mission"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/missions/{id}/team/{catId}": {
+            "delete": {
+                "description": "Takes a cat off the team of a mission and makes it available again. Removing the lead unassigns the mission's cat and releases the whole team.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "missions"
+                ],
+                "summary": "Remove a cat from a mission team",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Mission ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Cat ID",
+                        "name": "catId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Mission"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/missions/{id}/timeline": {
+            "get": {
+                "description": "Retrieves everything that happened to a mission in order: creation, cat assignments, target changes, status changes and completion, each with the actor who made it and a kind-specific payload. Send the X-Actor header on changes to be named in their events.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "missions"
+                ],
+                "summary": "Get a mission's timeline",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Mission ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.MissionEvent"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payroll/runs": {
+            "get": {
+                "description": "Retrieves all payroll runs without their entries, latest period first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payroll"
+                ],
+                "summary": "List payroll runs",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.PayrollRun"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Calculates prorated pay and mission completion bonuses for every cat over an inclusive period of at most 366 days and stores the result as an immutable payroll run.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payroll"
+                ],
+                "summary": "Run payroll for a period",
+                "parameters": [
+                    {
+                        "description": "Payroll period",
+                        "name": "run",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreatePayrollRunRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/domain.PayrollRun"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payroll/runs/{id}": {
+            "get": {
+                "description": "Retrieves a payroll run including the pay of every cat.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payroll"
+                ],
+                "summary": "Get a payroll run by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Payroll run ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.PayrollRun"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payroll/runs/{id}/export": {
+            "get": {
+                "description": "Downloads the entries of a payroll run as a CSV file with one row per cat.",
+                "produces": [
+                    "text/csv"
+                ],
+                "tags": [
+                    "payroll"
+                ],
+                "summary": "Export a payroll run as CSV",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Payroll run ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/skills": {
+            "get": {
+                "description": "Retrieves the skill catalog.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "skills"
+                ],
+                "summary": "List skills",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.Skill"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a new skill, such as infiltration or surveillance, to the skill catalog.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "skills"
+                ],
+                "summary": "Create a skill",
+                "parameters": [
+                    {
+                        "description": "Skill to create",
+                        "name": "skill",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateSkillRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Skill"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/targets/{id}": {
+            "delete": {
+                "description": "Deletes a target from a mission if it is not yet completed and the mission hasn't ended. The last open target of a mission in progress cannot be deleted; complete it instead.",
+                "tags": [
+                    "targets"
+                ],
+                "summary": "Delete a target",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Target ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/targets/{id}/complete": {
+            "patch": {
+                "description": "Marks a target as complete; targets that are already complete are rejected. If all targets in the mission are complete, the mission is also marked as complete.",
+                "tags": [
+                    "targets"
+                ],
+                "summary": "Complete a target",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Target ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Target"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/targets/{id}/notes": {
+            "patch": {
+                "description": "Updates the notes for a specific target if it is not completed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "targets"
+                ],
+                "summary": "Update target notes",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Target ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New notes",
+                        "name": "notes",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.UpdateTargetNotesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Target"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "domain.BreedDetails": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "life_span": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "origin": {
+                    "type": "string"
+                },
+                "temperament": {
+                    "type": "string"
+                },
+                "weight_metric": {
+                    "type": "string"
+                },
+                "wikipedia_url": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.Cat": {
+            "type": "object",
+            "properties": {
+                "archive_reason": {
+                    "type": "string"
+                },
+                "archived_at": {
+                    "description": "Set when the cat is retired",
+                    "type": "string"
+                },
+                "breed": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "salary": {
+                    "type": "number"
+                },
+                "status": {
+                    "$ref": "#/definitions/domain.CatStatus"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "years_of_experience": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.CatAvailability": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "description": "Whether the cat can take a mission today",
+                    "type": "boolean"
+                },
+                "cat_id": {
+                    "type": "integer"
+                },
+                "from": {
+                    "type": "string"
+                },
+                "leaves": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Leave"
+                    }
+                },
+                "status": {
+                    "$ref": "#/definitions/domain.CatStatus"
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.CatDossier": {
+            "type": "object",
+            "properties": {
+                "breed": {
+                    "description": "Nil if TheCatAPI no longer knows the breed",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.BreedDetails"
+                        }
+                    ]
+                },
+                "cat": {
+                    "$ref": "#/definitions/domain.Cat"
+                },
+                "image_url": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.CatImportReport": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer"
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "mode": {
+                    "$ref": "#/definitions/domain.ImportMode"
+                },
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.CatImportRow"
+                    }
+                }
+            }
+        },
+        "domain.CatImportRow": {
+            "type": "object",
+            "properties": {
+                "cat_id": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "row": {
+                    "description": "1-based, not counting a CSV header",
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.CatPage": {
+            "type": "object",
+            "properties": {
+                "cats": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Cat"
+                    }
+                },
+                "next_cursor": {
+                    "description": "Empty when there are no more results",
+                    "type": "string"
+                }
+            }
+        },
+        "domain.CatSkill": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "proficiency": {
+                    "type": "integer"
+                },
+                "skill_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.CatStats": {
+            "type": "object",
+            "properties": {
+                "avg_completion_hours": {
+                    "description": "From joining the team to completion; nil without completed missions",
+                    "type": "number"
+                },
+                "cat_id": {
+                    "type": "integer"
+                },
+                "cat_name": {
+                    "type": "string"
+                },
+                "countries": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "missions_assigned": {
+                    "type": "integer"
+                },
+                "missions_completed": {
+                    "type": "integer"
+                },
+                "rank": {
+                    "description": "Position on the leaderboard",
+                    "type": "integer"
+                },
+                "targets_completed": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.CatStatus": {
+            "type": "string",
+            "enum": [
+                "available",
+                "on_mission",
+                "on_leave",
+                "injured",
+                "suspended",
+                "retired"
+            ],
+            "x-enum-varnames": [
+                "CatStatusAvailable",
+                "CatStatusOnMission",
+                "CatStatusOnLeave",
+                "CatStatusInjured",
+                "CatStatusSuspended",
+                "CatStatusRetired"
+            ]
+        },
+        "domain.DependencyNode": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "codename": {
+                    "type": "string"
+                },
+                "depth": {
+                    "description": "1 for direct prerequisites and dependents",
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/domain.MissionStatus"
+                }
+            }
+        },
+        "domain.Expense": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "category": {
+                    "$ref": "#/definitions/domain.ExpenseCategory"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "mission_id": {
+                    "type": "integer"
+                },
+                "over_budget": {
+                    "description": "Recorded with an override despite exceeding the budget",
+                    "type": "boolean"
+                },
+                "spent_on": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.ExpenseCategory": {
+            "type": "string",
+            "enum": [
+                "travel",
+                "lodging",
+                "equipment",
+                "informants",
+                "other"
+            ],
+            "x-enum-varnames": [
+                "ExpenseCategoryTravel",
+                "ExpenseCategoryLodging",
+                "ExpenseCategoryEquipment",
+                "ExpenseCategoryInformants",
+                "ExpenseCategoryOther"
+            ]
+        },
+        "domain.ImportMode": {
+            "type": "string",
+            "enum": [
+                "atomic",
+                "best_effort"
+            ],
+            "x-enum-comments": {
+                "ImportModeAtomic": "Nothing is created unless every row is valid",
+                "ImportModeBestEffort": "Valid rows are created, invalid ones skipped"
+            },
+            "x-enum-descriptions": [
+                "Nothing is created unless every row is valid",
+                "Valid rows are created, invalid ones skipped"
+            ],
+            "x-enum-varnames": [
+                "ImportModeAtomic",
+                "ImportModeBestEffort"
+            ]
+        },
+        "domain.Leave": {
+            "type": "object",
+            "properties": {
+                "cat_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "description": "Inclusive",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "kind": {
+                    "$ref": "#/definitions/domain.LeaveKind"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.LeaveKind": {
+            "type": "string",
+            "enum": [
+                "leave",
+                "training"
+            ],
+            "x-enum-varnames": [
+                "LeaveKindLeave",
+                "LeaveKindTraining"
+            ]
+        },
+        "domain.Mission": {
+            "type": "object",
+            "properties": {
+                "assigned_at": {
+                    "type": "string"
+                },
+                "budget": {
+                    "description": "Nil if spending isn't limited",
+                    "type": "number"
+                },
+                "cat_id": {
+                    "description": "Nullable, as a mission can be unassigned",
+                    "type": "integer"
+                },
+                "cloned_from_id": {
+                    "type": "integer"
+                },
+                "code": {
+                    "description": "Short reference code used by field teams",
+                    "type": "string"
+                },
+                "codename": {
+                    "type": "string"
+                },
+                "completed": {
+                    "description": "Derived from the status",
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deadline": {
+                    "type": "string"
+                },
+                "ended_at": {
+                    "description": "Set once the mission is completed, aborted or failed",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "overdue_at": {
+                    "description": "When the mission was flagged as overdue",
+                    "type": "string"
+                },
+                "priority": {
+                    "type": "integer"
+                },
+                "required_skills": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.SkillRequirement"
+                    }
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/domain.MissionStatus"
+                },
+                "targets": {
+                    "description": "Skip DB mapping for nested slice",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Target"
+                    }
+                },
+                "team": {
+                    "description": "The lead first, loaded with single missions",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.TeamMember"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.MissionCandidate": {
+            "type": "object",
+            "properties": {
+                "cat": {
+                    "$ref": "#/definitions/domain.Cat"
+                },
+                "country_score": {
+                    "type": "number"
+                },
+                "experience_score": {
+                    "type": "number"
+                },
+                "explanation": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "score": {
+                    "type": "number"
+                },
+                "workload_penalty": {
+                    "type": "number"
+                }
+            }
+        },
+        "domain.MissionDependency": {
+            "type": "object",
+            "properties": {
+                "mission_id": {
+                    "type": "integer"
+                },
+                "prerequisite_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.MissionDependencyGraph": {
+            "type": "object",
+            "properties": {
+                "downstream": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.DependencyNode"
+                    }
+                },
+                "edges": {
+                    "description": "Every link between the missions of the graph",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.MissionDependency"
+                    }
+                },
+                "mission_id": {
+                    "type": "integer"
+                },
+                "upstream": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.DependencyNode"
+                    }
+                }
+            }
+        },
+        "domain.MissionEvent": {
+            "type": "object",
+            "properties": {
+                "actor": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "kind": {
+                    "$ref": "#/definitions/domain.MissionEventKind"
+                },
+                "mission_id": {
+                    "type": "integer"
+                },
+                "payload": {
+                    "description": "Details that depend on the kind",
+                    "type": "object"
+                }
+            }
+        },
+        "domain.MissionEventKind": {
+            "type": "string",
+            "enum": [
+                "mission_created",
+                "cat_assigned",
+                "cat_unassigned",
+                "status_changed",
+                "mission_completed",
+                "target_added",
+                "target_notes_updated",
+                "target_completed",
+                "target_deleted"
+            ],
+            "x-enum-varnames": [
+                "MissionEventCreated",
+                "MissionEventCatAssigned",
+                "MissionEventCatUnassigned",
+                "MissionEventStatusChanged",
+                "MissionEventCompleted",
+                "MissionEventTargetAdded",
+                "MissionEventTargetNotesUpdated",
+                "MissionEventTargetCompleted",
+                "MissionEventTargetDeleted"
+            ]
+        },
+        "domain.MissionFinance": {
+            "type": "object",
+            "properties": {
+                "budget": {
+                    "description": "Nil if spending isn't limited",
+                    "type": "number"
+                },
+                "by_category": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number",
+                        "format": "float64"
+                    }
+                },
+                "mission_id": {
+                    "type": "integer"
+                },
+                "remaining": {
+                    "description": "Negative once overridden expenses exceed the budget",
+                    "type": "number"
+                },
+                "salaries": {
+                    "description": "One per stint of a cat on the team while the mission was running",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.MissionSalaryCost"
+                    }
+                },
+                "salary_cost": {
+                    "description": "Sum of the salaries",
+                    "type": "number"
+                },
+                "spent": {
+                    "description": "Sum of the expenses",
+                    "type": "number"
+                },
+                "total_cost": {
+                    "description": "Expenses plus salary cost",
+                    "type": "number"
+                }
+            }
+        },
+        "domain.MissionPage": {
+            "type": "object",
+            "properties": {
+                "missions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Mission"
+                    }
+                },
+                "next_cursor": {
+                    "description": "Empty when there are no more results",
+                    "type": "string"
+                }
+            }
+        },
+        "domain.MissionSalaryCost": {
+            "type": "object",
+            "properties": {
+                "cat_id": {
+                    "type": "integer"
+                },
+                "cost": {
+                    "type": "number"
+                },
+                "days": {
+                    "type": "integer"
+                },
+                "from": {
+                    "type": "string"
+                },
+                "role": {
+                    "$ref": "#/definitions/domain.TeamRole"
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.MissionStatus": {
+            "type": "string",
+            "enum": [
+                "draft",
+                "assigned",
+                "in_progress",
+                "completed",
+                "aborted",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "MissionStatusDraft",
+                "MissionStatusAssigned",
+                "MissionStatusInProgress",
+                "MissionStatusCompleted",
+                "MissionStatusAborted",
+                "MissionStatusFailed"
+            ]
+        },
+        "domain.MissionTemplate": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "targets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.TemplateTarget"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.PayrollEntry": {
+            "type": "object",
+            "properties": {
+                "base_pay": {
+                    "type": "number"
+                },
+                "bonus": {
+                    "type": "number"
+                },
+                "cat_id": {
+                    "type": "integer"
+                },
+                "cat_name": {
+                    "type": "string"
+                },
+                "days_worked": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "missions_completed": {
+                    "type": "integer"
+                },
+                "run_id": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "number"
+                }
+            }
+        },
+        "domain.PayrollRun": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.PayrollEntry"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "mission_bonus": {
+                    "type": "number"
+                },
+                "period_end": {
+                    "description": "Inclusive",
+                    "type": "string"
+                },
+                "period_start": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "number"
+                }
+            }
+        },
+        "domain.SalaryChange": {
+            "type": "object",
+            "properties": {
+                "cat_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "effective_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "new_salary": {
+                    "type": "number"
+                },
+                "old_salary": {
+                    "description": "Nil for the starting salary",
+                    "type": "number"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.Skill": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.SkillRequirement": {
+            "type": "object",
+            "properties": {
+                "min_proficiency": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "skill_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.Target": {
+            "type": "object",
+            "properties": {
+                "completed": {
                     "type": "boolean"
                 },
                 "country": {
@@ -807,6 +3708,99 @@ const docTemplate = `{
                 }
             }
         },
+        "domain.TeamMember": {
+            "type": "object",
+            "properties": {
+                "cat_id": {
+                    "type": "integer"
+                },
+                "cat_name": {
+                    "type": "string"
+                },
+                "joined_at": {
+                    "type": "string"
+                },
+                "left_at": {
+                    "description": "Set once the cat left the team before the mission ended",
+                    "type": "string"
+                },
+                "mission_id": {
+                    "type": "integer"
+                },
+                "role": {
+                    "$ref": "#/definitions/domain.TeamRole"
+                }
+            }
+        },
+        "domain.TeamRole": {
+            "type": "string",
+            "enum": [
+                "lead",
+                "support",
+                "handler_liaison"
+            ],
+            "x-enum-varnames": [
+                "TeamRoleLead",
+                "TeamRoleSupport",
+                "TeamRoleHandlerLiaison"
+            ]
+        },
+        "domain.TemplateTarget": {
+            "type": "object",
+            "properties": {
+                "country": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "notes": {
+                    "description": "Default notes of the created target",
+                    "type": "string"
+                },
+                "position": {
+                    "description": "1-based",
+                    "type": "integer"
+                },
+                "template_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.AddDependencyRequest": {
+            "type": "object",
+            "required": [
+                "prerequisite_id"
+            ],
+            "properties": {
+                "prerequisite_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.AddTeamMemberRequest": {
+            "type": "object",
+            "required": [
+                "cat_id",
+                "role"
+            ],
+            "properties": {
+                "cat_id": {
+                    "type": "integer"
+                },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "lead",
+                        "support",
+                        "handler_liaison"
+                    ]
+                }
+            }
+        },
         "handler.AssignCatRequest": {
             "type": "object",
             "required": [
@@ -818,6 +3812,53 @@ const docTemplate = `{
                 }
             }
         },
+        "handler.ChangeCatStatusRequest": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "available",
+                        "on_mission",
+                        "on_leave",
+                        "injured",
+                        "suspended",
+                        "retired"
+                    ]
+                }
+            }
+        },
+        "handler.ChangeMissionStatusRequest": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "draft",
+                        "assigned",
+                        "in_progress",
+                        "completed",
+                        "aborted",
+                        "failed"
+                    ]
+                }
+            }
+        },
+        "handler.CloneMissionRequest": {
+            "type": "object",
+            "properties": {
+                "keep_notes": {
+                    "description": "Copy the notes of the targets instead of resetting them",
+                    "type": "boolean"
+                }
+            }
+        },
         "handler.CompleteMissionRequest": {
             "type": "object",
             "required": [
@@ -853,15 +3894,124 @@ const docTemplate = `{
                 }
             }
         },
+        "handler.CreateExpenseRequest": {
+            "type": "object",
+            "required": [
+                "amount"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "category": {
+                    "description": "Defaults to other",
+                    "type": "string",
+                    "enum": [
+                        "travel",
+                        "lodging",
+                        "equipment",
+                        "informants",
+                        "other"
+                    ]
+                },
+                "date": {
+                    "description": "Defaults to today",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "override": {
+                    "description": "Record the expense even if it exceeds the budget",
+                    "type": "boolean"
+                }
+            }
+        },
+        "handler.CreateLeaveRequest": {
+            "type": "object",
+            "required": [
+                "end_date",
+                "start_date"
+            ],
+            "properties": {
+                "end_date": {
+                    "description": "Inclusive",
+                    "type": "string"
+                },
+                "kind": {
+                    "description": "Defaults to leave",
+                    "type": "string",
+                    "enum": [
+                        "leave",
+                        "training"
+                    ]
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.CreateMissionFromTemplateRequest": {
+            "type": "object",
+            "properties": {
+                "cat_id": {
+                    "type": "integer"
+                },
+                "deadline": {
+                    "type": "string"
+                },
+                "priority": {
+                    "description": "1 is the most urgent, defaults to 3",
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1
+                },
+                "required_skills": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.SkillRequirementRequest"
+                    }
+                },
+                "targets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.TargetOverrideRequest"
+                    }
+                }
+            }
+        },
         "handler.CreateMissionRequest": {
             "type": "object",
             "required": [
                 "targets"
             ],
             "properties": {
+                "budget": {
+                    "description": "Omit for unlimited spending",
+                    "type": "number",
+                    "minimum": 0
+                },
                 "cat_id": {
                     "type": "integer"
                 },
+                "deadline": {
+                    "type": "string"
+                },
+                "priority": {
+                    "description": "1 is the most urgent, defaults to 3",
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1
+                },
+                "required_skills": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.SkillRequirementRequest"
+                    }
+                },
                 "targets": {
                     "type": "array",
                     "maxItems": 3,
@@ -872,6 +4022,40 @@ const docTemplate = `{
                 }
             }
         },
+        "handler.CreatePayrollRunRequest": {
+            "type": "object",
+            "required": [
+                "period_end",
+                "period_start"
+            ],
+            "properties": {
+                "mission_bonus": {
+                    "description": "Defaults to the configured bonus",
+                    "type": "number",
+                    "minimum": 0
+                },
+                "period_end": {
+                    "type": "string"
+                },
+                "period_start": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.CreateSkillRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
         "handler.CreateTargetRequest": {
             "type": "object",
             "required": [
@@ -893,8 +4077,18 @@ const docTemplate = `{
         "handler.ErrorResponse": {
             "type": "object",
             "properties": {
+                "code": {
+                    "type": "integer"
+                },
                 "error": {
                     "type": "string"
+                },
+                "missing_skills": {
+                    "description": "Skills a cat lacks for a mission",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.SkillRequirement"
+                    }
                 }
             }
         },
@@ -906,12 +4100,138 @@ const docTemplate = `{
                 }
             }
         },
+        "handler.MissionTemplateRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "targets"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "targets": {
+                    "type": "array",
+                    "maxItems": 3,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/handler.CreateTargetRequest"
+                    }
+                }
+            }
+        },
+        "handler.SetBudgetRequest": {
+            "type": "object",
+            "properties": {
+                "budget": {
+                    "type": "number",
+                    "minimum": 0
+                }
+            }
+        },
+        "handler.SetCatSkillRequest": {
+            "type": "object",
+            "required": [
+                "proficiency"
+            ],
+            "properties": {
+                "proficiency": {
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1
+                }
+            }
+        },
+        "handler.SetRequiredSkillsRequest": {
+            "type": "object",
+            "properties": {
+                "required_skills": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.SkillRequirementRequest"
+                    }
+                }
+            }
+        },
+        "handler.SkillRequirementRequest": {
+            "type": "object",
+            "required": [
+                "min_proficiency",
+                "skill_id"
+            ],
+            "properties": {
+                "min_proficiency": {
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1
+                },
+                "skill_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.TargetOverrideRequest": {
+            "type": "object",
+            "required": [
+                "position"
+            ],
+            "properties": {
+                "country": {
+                    "type": "string",
+                    "minLength": 1
+                },
+                "name": {
+                    "type": "string",
+                    "minLength": 1
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "handler.UpdateCatRequest": {
+            "type": "object",
+            "properties": {
+                "breed": {
+                    "type": "string",
+                    "minLength": 1
+                },
+                "name": {
+                    "type": "string",
+                    "minLength": 1
+                },
+                "salary": {
+                    "type": "number"
+                },
+                "salary_reason": {
+                    "type": "string"
+                },
+                "years_of_experience": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
         "handler.UpdateCatSalaryRequest": {
             "type": "object",
             "required": [
                 "salary"
             ],
             "properties": {
+                "effective_date": {
+                    "description": "Defaults to today",
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
                 "salary": {
                     "type": "number"
                 }
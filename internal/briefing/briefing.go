@@ -0,0 +1,142 @@
+// Package briefing renders mission briefing documents from templates. The default
+// templates are embedded in the binary and can be overridden by files on disk.
+package briefing
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"os"
+	"path/filepath"
+	"spy_cats_agency/internal/domain"
+	"strings"
+	texttemplate "text/template"
+	"time"
+)
+
+// Formats a briefing can be rendered in.
+const (
+	FormatMarkdown = "markdown"
+	FormatHTML     = "html"
+)
+
+// Template file names, both embedded and in the override directory.
+const (
+	markdownTemplate = "briefing.md.tmpl"
+	htmlTemplate     = "briefing.html.tmpl"
+)
+
+//go:embed templates/*.tmpl
+var defaultTemplates embed.FS
+
+// Data is what briefing templates are rendered with.
+type Data struct {
+	Mission     domain.Mission
+	Cat         *domain.CatDossier // Nil if no cat is assigned
+	GeneratedAt time.Time
+}
+
+// Renderer renders briefings with parsed templates.
+type Renderer struct {
+	markdown *texttemplate.Template
+	html     *htmltemplate.Template
+}
+
+// NewRenderer parses the briefing templates. A template file in dir replaces the
+// embedded default of the same name. An empty dir uses the defaults only.
+func NewRenderer(dir string) (*Renderer, error) {
+	markdownSource, err := readTemplate(dir, markdownTemplate)
+	if err != nil {
+		return nil, err
+	}
+	htmlSource, err := readTemplate(dir, htmlTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	markdown, err := texttemplate.New(markdownTemplate).Funcs(texttemplate.FuncMap(funcs)).Parse(markdownSource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse markdown briefing template: %w", err)
+	}
+	html, err := htmltemplate.New(htmlTemplate).Funcs(htmltemplate.FuncMap(funcs)).Parse(htmlSource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse HTML briefing template: %w", err)
+	}
+
+	return &Renderer{markdown: markdown, html: html}, nil
+}
+
+// Render renders a briefing in the given format.
+func (r *Renderer) Render(format string, data Data) ([]byte, error) {
+	var buf bytes.Buffer
+	var err error
+	switch format {
+	case FormatMarkdown:
+		err = r.markdown.Execute(&buf, data)
+	case FormatHTML:
+		err = r.html.Execute(&buf, data)
+	default:
+		return nil, fmt.Errorf("invalid briefing format: %s", format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to render briefing: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// readTemplate returns the source of the named template from dir if it exists there,
+// and the embedded default otherwise.
+func readTemplate(dir, name string) (string, error) {
+	if dir != "" {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err == nil {
+			return string(data), nil
+		}
+		if !os.IsNotExist(err) {
+			return "", fmt.Errorf("failed to read briefing template: %w", err)
+		}
+	}
+
+	data, err := defaultTemplates.ReadFile("templates/" + name)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// funcs are the helpers available to both templates.
+var funcs = map[string]any{
+	"date": func(t any) string {
+		switch t := t.(type) {
+		case time.Time:
+			return t.Format("2006-01-02 15:04 MST")
+		case *time.Time:
+			if t != nil {
+				return t.Format("2006-01-02 15:04 MST")
+			}
+		}
+		return "none"
+	},
+	"money": func(amount any) string {
+		switch amount := amount.(type) {
+		case float64:
+			return fmt.Sprintf("%.2f", amount)
+		case *float64:
+			if amount != nil {
+				return fmt.Sprintf("%.2f", *amount)
+			}
+		}
+		return "none"
+	},
+	"add": func(a, b int) int {
+		return a + b
+	},
+	"title": func(s string) string {
+		s = strings.ReplaceAll(s, "_", " ")
+		if s == "" {
+			return s
+		}
+		return strings.ToUpper(s[:1]) + s[1:]
+	},
+}
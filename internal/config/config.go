@@ -1,6 +1,9 @@
 package config
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/spf13/viper"
 )
 
@@ -14,6 +17,20 @@ type Config struct {
 	DBPort         string `mapstructure:"DB_PORT"`
 	ServerPort     string `mapstructure:"SERVER_PORT"`
 	CatAPIEndpoint string `mapstructure:"CAT_API_ENDPOINT"`
+	CatAPIKey      string `mapstructure:"CAT_API_KEY"` // Optional
+
+	// PayrollMissionBonus is the default bonus paid per completed mission in a payroll run.
+	PayrollMissionBonus float64 `mapstructure:"PAYROLL_MISSION_BONUS"`
+
+	// LeaveSyncInterval is how often cats are moved on and off leave as leave periods start and end.
+	LeaveSyncInterval time.Duration `mapstructure:"LEAVE_SYNC_INTERVAL"`
+
+	// OverdueSweepInterval is how often missions past their deadline are flagged as overdue.
+	OverdueSweepInterval time.Duration `mapstructure:"OVERDUE_SWEEP_INTERVAL"`
+
+	// BriefingTemplateDir is a directory whose briefing.md.tmpl and briefing.html.tmpl
+	// replace the built-in mission briefing templates. Optional.
+	BriefingTemplateDir string `mapstructure:"BRIEFING_TEMPLATE_DIR"`
 }
 
 // LoadConfig reads configuration from file or environment variables.
@@ -24,6 +41,13 @@ func LoadConfig(path string) (config Config, err error) {
 
 	viper.AutomaticEnv()
 
+	// Optional settings need a default so that viper picks them up from the environment.
+	viper.SetDefault("CAT_API_KEY", "")
+	viper.SetDefault("PAYROLL_MISSION_BONUS", 0)
+	viper.SetDefault("LEAVE_SYNC_INTERVAL", "1h")
+	viper.SetDefault("OVERDUE_SWEEP_INTERVAL", "5m")
+	viper.SetDefault("BRIEFING_TEMPLATE_DIR", "")
+
 	err = viper.ReadInConfig()
 	if err != nil {
 		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
@@ -31,6 +55,16 @@ func LoadConfig(path string) (config Config, err error) {
 		}
 	}
 
-	err = viper.Unmarshal(&config)
+	if err = viper.Unmarshal(&config); err != nil {
+		return
+	}
+
+	// Background jobs tick at these intervals, which must be positive
+	switch {
+	case config.LeaveSyncInterval <= 0:
+		err = fmt.Errorf("LEAVE_SYNC_INTERVAL must be a positive duration, got %s", config.LeaveSyncInterval)
+	case config.OverdueSweepInterval <= 0:
+		err = fmt.Errorf("OVERDUE_SWEEP_INTERVAL must be a positive duration, got %s", config.OverdueSweepInterval)
+	}
 	return
 }
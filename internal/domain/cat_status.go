@@ -0,0 +1,40 @@
+package domain
+
+// CatStatus is the duty status of a spy cat.
+type CatStatus string
+
+// Cat statuses.
+const (
+	CatStatusAvailable CatStatus = "available"
+	CatStatusOnMission CatStatus = "on_mission"
+	CatStatusOnLeave   CatStatus = "on_leave"
+	CatStatusInjured   CatStatus = "injured"
+	CatStatusSuspended CatStatus = "suspended"
+	CatStatusRetired   CatStatus = "retired"
+)
+
+// catStatusTransitions lists the statuses each status may move to.
+var catStatusTransitions = map[CatStatus][]CatStatus{
+	CatStatusAvailable: {CatStatusOnMission, CatStatusOnLeave, CatStatusInjured, CatStatusSuspended, CatStatusRetired},
+	CatStatusOnMission: {CatStatusAvailable, CatStatusInjured, CatStatusSuspended},
+	CatStatusOnLeave:   {CatStatusAvailable, CatStatusInjured, CatStatusRetired},
+	CatStatusInjured:   {CatStatusAvailable, CatStatusOnLeave, CatStatusRetired},
+	CatStatusSuspended: {CatStatusAvailable, CatStatusRetired},
+	CatStatusRetired:   {CatStatusAvailable},
+}
+
+// IsValid reports whether s is a known status.
+func (s CatStatus) IsValid() bool {
+	_, ok := catStatusTransitions[s]
+	return ok
+}
+
+// CanTransitionTo reports whether a cat in status s may move to next.
+func (s CatStatus) CanTransitionTo(next CatStatus) bool {
+	for _, allowed := range catStatusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
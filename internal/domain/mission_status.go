@@ -0,0 +1,46 @@
+package domain
+
+// MissionStatus is the lifecycle stage of a mission.
+type MissionStatus string
+
+// Mission statuses.
+const (
+	MissionStatusDraft      MissionStatus = "draft"
+	MissionStatusAssigned   MissionStatus = "assigned"
+	MissionStatusInProgress MissionStatus = "in_progress"
+	MissionStatusCompleted  MissionStatus = "completed"
+	MissionStatusAborted    MissionStatus = "aborted"
+	MissionStatusFailed     MissionStatus = "failed"
+)
+
+// missionStatusTransitions lists the statuses each status may move to. Completed,
+// aborted and failed missions have ended and can't move anymore.
+var missionStatusTransitions = map[MissionStatus][]MissionStatus{
+	MissionStatusDraft:      {MissionStatusAssigned, MissionStatusAborted},
+	MissionStatusAssigned:   {MissionStatusInProgress, MissionStatusDraft, MissionStatusAborted},
+	MissionStatusInProgress: {MissionStatusCompleted, MissionStatusAborted, MissionStatusFailed},
+	MissionStatusCompleted:  {},
+	MissionStatusAborted:    {},
+	MissionStatusFailed:     {},
+}
+
+// IsValid reports whether s is a known status.
+func (s MissionStatus) IsValid() bool {
+	_, ok := missionStatusTransitions[s]
+	return ok
+}
+
+// IsTerminal reports whether a mission in status s has ended.
+func (s MissionStatus) IsTerminal() bool {
+	return s.IsValid() && len(missionStatusTransitions[s]) == 0
+}
+
+// CanTransitionTo reports whether a mission in status s may move to next.
+func (s MissionStatus) CanTransitionTo(next MissionStatus) bool {
+	for _, allowed := range missionStatusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
@@ -1,29 +1,430 @@
 package domain
 
 import (
+	"encoding/json"
 	"time"
 )
 
 // Cat represents a spy cat in the system.
 type Cat struct {
-	ID                int       `db:"id" json:"id"`
-	Name              string    `db:"name" json:"name"`
-	YearsOfExperience int       `db:"years_of_experience" json:"years_of_experience"`
-	Breed             string    `db:"breed" json:"breed"`
-	Salary            float64   `db:"salary" json:"salary"`
-	Status            string    `db:"status" json:"status"`
-	CreatedAt         time.Time `db:"created_at" json:"created_at"`
-	UpdatedAt         time.Time `db:"updated_at" json:"updated_at"`
+	ID                int        `db:"id" json:"id"`
+	Name              string     `db:"name" json:"name"`
+	YearsOfExperience int        `db:"years_of_experience" json:"years_of_experience"`
+	Breed             string     `db:"breed" json:"breed"`
+	Salary            float64    `db:"salary" json:"salary"`
+	Status            CatStatus  `db:"status" json:"status"`
+	ArchivedAt        *time.Time `db:"archived_at" json:"archived_at,omitempty"` // Set when the cat is retired
+	ArchiveReason     string     `db:"archive_reason" json:"archive_reason,omitempty"`
+	CreatedAt         time.Time  `db:"created_at" json:"created_at"`
+	UpdatedAt         time.Time  `db:"updated_at" json:"updated_at"`
 }
 
-// Mission represents a mission assigned to a spy cat.
-type Mission struct {
+// BreedDetails describes a cat breed as documented by TheCatAPI.
+type BreedDetails struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	Temperament  string `json:"temperament"`
+	Origin       string `json:"origin"`
+	LifeSpan     string `json:"life_span"`
+	WeightMetric string `json:"weight_metric"`
+	WikipediaURL string `json:"wikipedia_url,omitempty"`
+}
+
+// CatDossier is a cat's profile together with the details of its breed.
+type CatDossier struct {
+	Cat      Cat           `json:"cat"`
+	Breed    *BreedDetails `json:"breed"` // Nil if TheCatAPI no longer knows the breed
+	ImageURL string        `json:"image_url,omitempty"`
+}
+
+// LeaveKind distinguishes the reasons a cat can be away from duty.
+type LeaveKind string
+
+// Leave kinds.
+const (
+	LeaveKindLeave    LeaveKind = "leave"
+	LeaveKindTraining LeaveKind = "training"
+)
+
+// Leave is a period during which a cat is away from duty and can't take missions.
+type Leave struct {
 	ID        int       `db:"id" json:"id"`
-	CatID     *int      `db:"cat_id" json:"cat_id"` // Nullable, as a mission can be unassigned
-	Completed bool      `db:"completed" json:"completed"`
-	Targets   []Target  `db:"-" json:"targets"` // Skip DB mapping for nested slice
+	CatID     int       `db:"cat_id" json:"cat_id"`
+	Kind      LeaveKind `db:"kind" json:"kind"`
+	StartDate time.Time `db:"start_date" json:"start_date"`
+	EndDate   time.Time `db:"end_date" json:"end_date"` // Inclusive
+	Reason    string    `db:"reason" json:"reason"`
 	CreatedAt time.Time `db:"created_at" json:"created_at"`
-	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// CatAvailability is a cat's calendar of leave periods within a date range.
+type CatAvailability struct {
+	CatID     int       `json:"cat_id"`
+	Status    CatStatus `json:"status"`
+	From      time.Time `json:"from"`
+	To        time.Time `json:"to"`
+	Available bool      `json:"available"` // Whether the cat can take a mission today
+	Leaves    []Leave   `json:"leaves"`
+}
+
+// Sort keys of the cat leaderboard.
+const (
+	CatStatsSortMissionsCompleted = "missions_completed"
+	CatStatsSortTargetsCompleted  = "targets_completed"
+	CatStatsSortMissionsAssigned  = "missions_assigned"
+	CatStatsSortAvgCompletion     = "avg_completion_hours"
+)
+
+// CatStats summarizes the performance of a cat across its missions.
+type CatStats struct {
+	Rank               int      `db:"-" json:"rank,omitempty"` // Position on the leaderboard
+	CatID              int      `db:"cat_id" json:"cat_id"`
+	CatName            string   `db:"cat_name" json:"cat_name"`
+	MissionsAssigned   int      `db:"missions_assigned" json:"missions_assigned"`
+	MissionsCompleted  int      `db:"missions_completed" json:"missions_completed"`
+	TargetsCompleted   int      `db:"targets_completed" json:"targets_completed"`
+	AvgCompletionHours *float64 `db:"avg_completion_hours" json:"avg_completion_hours"` // From joining the team to completion; nil without completed missions
+	Countries          []string `db:"-" json:"countries"`
+}
+
+// CatUpdate holds the changes of a partial cat update. Nil fields are left unchanged.
+type CatUpdate struct {
+	Name              *string
+	YearsOfExperience *int
+	Breed             *string
+	Salary            *float64
+	SalaryReason      string // Recorded in the salary history when Salary changes
+}
+
+// CatRetirement is a period during which a cat was retired.
+type CatRetirement struct {
+	ID         int        `db:"id" json:"id"`
+	CatID      int        `db:"cat_id" json:"cat_id"`
+	RetiredAt  time.Time  `db:"retired_at" json:"retired_at"`
+	RestoredAt *time.Time `db:"restored_at" json:"restored_at"` // Nil while the cat is retired
+	Reason     string     `db:"reason" json:"reason"`
+}
+
+// SalaryChange is an entry in a cat's append-only salary history.
+type SalaryChange struct {
+	ID            int       `db:"id" json:"id"`
+	CatID         int       `db:"cat_id" json:"cat_id"`
+	OldSalary     *float64  `db:"old_salary" json:"old_salary"` // Nil for the starting salary
+	NewSalary     float64   `db:"new_salary" json:"new_salary"`
+	EffectiveDate time.Time `db:"effective_date" json:"effective_date"`
+	Reason        string    `db:"reason" json:"reason"`
+	CreatedAt     time.Time `db:"created_at" json:"created_at"`
+}
+
+// Sort orders accepted by the listing filters.
+const (
+	SortAsc  = "asc"
+	SortDesc = "desc"
+)
+
+// Sort keys accepted by CatFilter.SortBy.
+const (
+	CatSortCreatedAt         = "created_at"
+	CatSortName              = "name"
+	CatSortYearsOfExperience = "years_of_experience"
+	CatSortSalary            = "salary"
+)
+
+// CatFilter describes how a list of cats should be filtered, sorted and paginated.
+// Zero values mean "no constraint", except that retired cats are only listed when
+// IncludeArchived is set. An empty SortBy sorts by creation time, and an
+// empty SortOrder uses the natural order of the key (newest first for creation time,
+// ascending otherwise).
+type CatFilter struct {
+	Status          CatStatus
+	Breed           string
+	Name            string // Case-insensitive substring match
+	MinExperience   *int
+	MaxExperience   *int
+	MinSalary       *float64
+	MaxSalary       *float64
+	IncludeArchived bool
+	SortBy          string
+	SortOrder       string
+	Cursor          string // Opaque cursor returned as CatPage.NextCursor
+	Limit           int
+}
+
+// CatPage is a single page of cats returned by a filtered listing.
+type CatPage struct {
+	Cats       []Cat  `json:"cats"`
+	NextCursor string `json:"next_cursor,omitempty"` // Empty when there are no more results
+}
+
+// ImportMode decides what happens to the valid rows of an import when other rows fail.
+type ImportMode string
+
+// Import modes.
+const (
+	ImportModeAtomic     ImportMode = "atomic"      // Nothing is created unless every row is valid
+	ImportModeBestEffort ImportMode = "best_effort" // Valid rows are created, invalid ones skipped
+)
+
+// CatImportRow is one row of a cat import and its outcome. Rows that already carry
+// an error, e.g. because they couldn't be parsed, are reported as failed.
+type CatImportRow struct {
+	Row   int    `json:"row"` // 1-based, not counting a CSV header
+	Cat   *Cat   `json:"-"`
+	CatID *int   `json:"cat_id,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+// CatImportReport is the per-row result of a cat import.
+type CatImportReport struct {
+	Mode    ImportMode     `json:"mode"`
+	Created int            `json:"created"`
+	Failed  int            `json:"failed"`
+	Rows    []CatImportRow `json:"rows"`
+}
+
+// Mission represents a mission assigned to a spy cat.
+type Mission struct {
+	ID             int                `db:"id" json:"id"`
+	Codename       string             `db:"codename" json:"codename"`
+	Code           string             `db:"code" json:"code"`     // Short reference code used by field teams
+	CatID          *int               `db:"cat_id" json:"cat_id"` // Nullable, as a mission can be unassigned
+	Status         MissionStatus      `db:"status" json:"status"`
+	Completed      bool               `db:"completed" json:"completed"` // Derived from the status
+	Priority       int                `db:"priority" json:"priority"`
+	Deadline       *time.Time         `db:"deadline" json:"deadline,omitempty"`
+	Budget         *float64           `db:"budget" json:"budget,omitempty"`         // Nil if spending isn't limited
+	OverdueAt      *time.Time         `db:"overdue_at" json:"overdue_at,omitempty"` // When the mission was flagged as overdue
+	ClonedFromID   *int               `db:"cloned_from_id" json:"cloned_from_id,omitempty"`
+	AssignedAt     *time.Time         `db:"assigned_at" json:"assigned_at,omitempty"`
+	StartedAt      *time.Time         `db:"started_at" json:"started_at,omitempty"`
+	EndedAt        *time.Time         `db:"ended_at" json:"ended_at,omitempty"` // Set once the mission is completed, aborted or failed
+	Targets        []Target           `db:"-" json:"targets"`                   // Skip DB mapping for nested slice
+	Team           []TeamMember       `db:"-" json:"team,omitempty"`            // The lead first, loaded with single missions
+	RequiredSkills []SkillRequirement `db:"-" json:"required_skills"`
+	CreatedAt      time.Time          `db:"created_at" json:"created_at"`
+	UpdatedAt      time.Time          `db:"updated_at" json:"updated_at"`
+}
+
+// MissionEventKind identifies what happened in a mission timeline event.
+type MissionEventKind string
+
+// Mission event kinds.
+const (
+	MissionEventCreated            MissionEventKind = "mission_created"
+	MissionEventCatAssigned        MissionEventKind = "cat_assigned"
+	MissionEventCatUnassigned      MissionEventKind = "cat_unassigned"
+	MissionEventStatusChanged      MissionEventKind = "status_changed"
+	MissionEventCompleted          MissionEventKind = "mission_completed"
+	MissionEventTargetAdded        MissionEventKind = "target_added"
+	MissionEventTargetNotesUpdated MissionEventKind = "target_notes_updated"
+	MissionEventTargetCompleted    MissionEventKind = "target_completed"
+	MissionEventTargetDeleted      MissionEventKind = "target_deleted"
+)
+
+// MissionEvent is an entry in the timeline of a mission.
+type MissionEvent struct {
+	ID        int              `db:"id" json:"id"`
+	MissionID int              `db:"mission_id" json:"mission_id"`
+	Kind      MissionEventKind `db:"kind" json:"kind"`
+	Actor     string           `db:"actor" json:"actor"`
+	Payload   json.RawMessage  `db:"payload" json:"payload" swaggertype:"object"` // Details that depend on the kind
+	CreatedAt time.Time        `db:"created_at" json:"created_at"`
+}
+
+// MissionDependency links a mission to a prerequisite mission that must be completed
+// before it can start.
+type MissionDependency struct {
+	MissionID      int `db:"mission_id" json:"mission_id"`
+	PrerequisiteID int `db:"prerequisite_id" json:"prerequisite_id"`
+}
+
+// DependencyNode is a mission reached when following dependencies from another mission.
+type DependencyNode struct {
+	ID       int           `db:"id" json:"id"`
+	Codename string        `db:"codename" json:"codename"`
+	Code     string        `db:"code" json:"code"`
+	Status   MissionStatus `db:"status" json:"status"`
+	Depth    int           `db:"-" json:"depth"` // 1 for direct prerequisites and dependents
+}
+
+// MissionDependencyGraph is the transitive closure of a mission's dependencies. Upstream
+// missions must be completed before the mission starts, downstream missions wait for it.
+type MissionDependencyGraph struct {
+	MissionID  int                 `json:"mission_id"`
+	Upstream   []DependencyNode    `json:"upstream"`
+	Downstream []DependencyNode    `json:"downstream"`
+	Edges      []MissionDependency `json:"edges"` // Every link between the missions of the graph
+}
+
+// TeamRole is the part a cat plays in a mission team.
+type TeamRole string
+
+// Team roles. Every team with members has exactly one lead, the mission's assigned cat.
+const (
+	TeamRoleLead           TeamRole = "lead"
+	TeamRoleSupport        TeamRole = "support"
+	TeamRoleHandlerLiaison TeamRole = "handler_liaison"
+)
+
+// IsValid reports whether r is a known team role.
+func (r TeamRole) IsValid() bool {
+	switch r {
+	case TeamRoleLead, TeamRoleSupport, TeamRoleHandlerLiaison:
+		return true
+	}
+	return false
+}
+
+// TeamMember is a cat on a mission team.
+type TeamMember struct {
+	MissionID int        `db:"mission_id" json:"mission_id"`
+	CatID     int        `db:"cat_id" json:"cat_id"`
+	CatName   string     `db:"cat_name" json:"cat_name"`
+	Role      TeamRole   `db:"role" json:"role"`
+	JoinedAt  time.Time  `db:"joined_at" json:"joined_at"`
+	LeftAt    *time.Time `db:"left_at" json:"left_at,omitempty"` // Set once the cat left the team before the mission ended
+}
+
+// ExpenseCategory classifies what the money of an expense was spent on.
+type ExpenseCategory string
+
+// Expense categories.
+const (
+	ExpenseCategoryTravel     ExpenseCategory = "travel"
+	ExpenseCategoryLodging    ExpenseCategory = "lodging"
+	ExpenseCategoryEquipment  ExpenseCategory = "equipment"
+	ExpenseCategoryInformants ExpenseCategory = "informants"
+	ExpenseCategoryOther      ExpenseCategory = "other"
+)
+
+// IsValid reports whether c is a known expense category.
+func (c ExpenseCategory) IsValid() bool {
+	switch c {
+	case ExpenseCategoryTravel, ExpenseCategoryLodging, ExpenseCategoryEquipment, ExpenseCategoryInformants, ExpenseCategoryOther:
+		return true
+	}
+	return false
+}
+
+// Expense is an entry in a mission's expense ledger.
+type Expense struct {
+	ID          int             `db:"id" json:"id"`
+	MissionID   int             `db:"mission_id" json:"mission_id"`
+	Amount      float64         `db:"amount" json:"amount"`
+	Category    ExpenseCategory `db:"category" json:"category"`
+	SpentOn     time.Time       `db:"spent_on" json:"spent_on"`
+	Description string          `db:"description" json:"description"`
+	OverBudget  bool            `db:"over_budget" json:"over_budget"` // Recorded with an override despite exceeding the budget
+	CreatedAt   time.Time       `db:"created_at" json:"created_at"`
+}
+
+// MissionFinance summarizes the money spent on a mission.
+type MissionFinance struct {
+	MissionID  int                 `json:"mission_id"`
+	Budget     *float64            `json:"budget"`    // Nil if spending isn't limited
+	Spent      float64             `json:"spent"`     // Sum of the expenses
+	Remaining  *float64            `json:"remaining"` // Negative once overridden expenses exceed the budget
+	ByCategory map[string]float64  `json:"by_category"`
+	Salaries   []MissionSalaryCost `json:"salaries"`    // One per stint of a cat on the team while the mission was running
+	SalaryCost float64             `json:"salary_cost"` // Sum of the salaries
+	TotalCost  float64             `json:"total_cost"`  // Expenses plus salary cost
+}
+
+// MissionSalaryCost is the prorated salary of a cat over the inclusive days it was on
+// the team of a running mission.
+type MissionSalaryCost struct {
+	CatID int       `json:"cat_id"`
+	Role  TeamRole  `json:"role"`
+	From  time.Time `json:"from"`
+	To    time.Time `json:"to"`
+	Days  int       `json:"days"`
+	Cost  float64   `json:"cost"`
+}
+
+// Mission priorities, from the most to the least urgent.
+const (
+	MissionPriorityHighest = 1
+	MissionPriorityDefault = 3
+	MissionPriorityLowest  = 5
+)
+
+// Sort keys accepted by MissionFilter.SortBy.
+const (
+	MissionSortCreatedAt = "created_at"
+	MissionSortPriority  = "priority"
+	MissionSortDeadline  = "deadline"
+)
+
+// MissionFilter describes how a list of missions should be filtered, sorted and
+// paginated. Zero values mean "no constraint". An empty SortBy sorts by creation time,
+// and an empty SortOrder uses the natural order of the key (newest first for creation
+// time, most urgent first otherwise). Missions without a deadline come after those with one.
+type MissionFilter struct {
+	Completed      *bool
+	CatID          *int
+	Country        string     // Missions with at least one target in the country, case-insensitive
+	CreatedFrom    *time.Time // Inclusive
+	CreatedBefore  *time.Time // Exclusive
+	IncludeTargets bool
+	SortBy         string
+	SortOrder      string
+	Cursor         string // Opaque cursor returned as MissionPage.NextCursor
+	Limit          int
+}
+
+// MissionPage is a single page of missions returned by a filtered listing.
+type MissionPage struct {
+	Missions   []Mission `json:"missions"`
+	NextCursor string    `json:"next_cursor,omitempty"` // Empty when there are no more results
+}
+
+// MissionCandidate is an available cat ranked for assignment to a mission.
+type MissionCandidate struct {
+	Cat             Cat      `json:"cat"`
+	Score           float64  `json:"score"`
+	ExperienceScore float64  `json:"experience_score"`
+	CountryScore    float64  `json:"country_score"`
+	WorkloadPenalty float64  `json:"workload_penalty"`
+	Explanation     []string `json:"explanation"`
+}
+
+// MissionTemplate is a named skeleton of a mission's targets that missions can be created from.
+type MissionTemplate struct {
+	ID          int              `db:"id" json:"id"`
+	Name        string           `db:"name" json:"name"`
+	Description string           `db:"description" json:"description"`
+	Targets     []TemplateTarget `db:"-" json:"targets"`
+	CreatedAt   time.Time        `db:"created_at" json:"created_at"`
+	UpdatedAt   time.Time        `db:"updated_at" json:"updated_at"`
+}
+
+// TemplateTarget is the skeleton of a target within a mission template.
+type TemplateTarget struct {
+	ID         int    `db:"id" json:"id"`
+	TemplateID int    `db:"template_id" json:"template_id"`
+	Position   int    `db:"position" json:"position"` // 1-based
+	Name       string `db:"name" json:"name"`
+	Country    string `db:"country" json:"country"`
+	Notes      string `db:"notes" json:"notes"` // Default notes of the created target
+}
+
+// MissionOverrides customizes a mission created from a template. Nil and zero values
+// keep what the template and the mission defaults provide.
+type MissionOverrides struct {
+	CatID          *int
+	Priority       int
+	Deadline       *time.Time
+	RequiredSkills []SkillRequirement
+	Targets        []TargetOverride
+}
+
+// TargetOverride replaces fields of the template target at Position.
+type TargetOverride struct {
+	Position int
+	Name     *string
+	Country  *string
+	Notes    *string
 }
 
 // Target represents a target within a mission.
@@ -37,3 +438,58 @@ type Target struct {
 	CreatedAt time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
 }
+
+// Proficiency levels of a cat skill, from lowest to highest.
+const (
+	ProficiencyNovice     = 1
+	ProficiencyApprentice = 2
+	ProficiencyCompetent  = 3
+	ProficiencyExpert     = 4
+	ProficiencyMaster     = 5
+)
+
+// Skill is an entry in the catalog of skills a cat can have.
+type Skill struct {
+	ID          int       `db:"id" json:"id"`
+	Name        string    `db:"name" json:"name"`
+	Description string    `db:"description" json:"description"`
+	CreatedAt   time.Time `db:"created_at" json:"created_at"`
+}
+
+// CatSkill is a skill a cat has, at a proficiency level between 1 and 5.
+type CatSkill struct {
+	SkillID     int    `db:"skill_id" json:"skill_id"`
+	Name        string `db:"name" json:"name"`
+	Proficiency int    `db:"proficiency" json:"proficiency"`
+}
+
+// SkillRequirement is a skill a mission requires, at a minimum proficiency level.
+type SkillRequirement struct {
+	SkillID        int    `db:"skill_id" json:"skill_id"`
+	Name           string `db:"name" json:"name"`
+	MinProficiency int    `db:"min_proficiency" json:"min_proficiency"`
+}
+
+// PayrollRun is an immutable record of the pay calculated for every cat over a period.
+type PayrollRun struct {
+	ID           int            `db:"id" json:"id"`
+	PeriodStart  time.Time      `db:"period_start" json:"period_start"`
+	PeriodEnd    time.Time      `db:"period_end" json:"period_end"` // Inclusive
+	MissionBonus float64        `db:"mission_bonus" json:"mission_bonus"`
+	Total        float64        `db:"total" json:"total"`
+	Entries      []PayrollEntry `db:"-" json:"entries,omitempty"`
+	CreatedAt    time.Time      `db:"created_at" json:"created_at"`
+}
+
+// PayrollEntry is the pay of a single cat within a payroll run.
+type PayrollEntry struct {
+	ID                int     `db:"id" json:"id"`
+	RunID             int     `db:"run_id" json:"run_id"`
+	CatID             int     `db:"cat_id" json:"cat_id"`
+	CatName           string  `db:"cat_name" json:"cat_name"`
+	DaysWorked        int     `db:"days_worked" json:"days_worked"`
+	BasePay           float64 `db:"base_pay" json:"base_pay"`
+	MissionsCompleted int     `db:"missions_completed" json:"missions_completed"`
+	Bonus             float64 `db:"bonus" json:"bonus"`
+	Total             float64 `db:"total" json:"total"`
+}
@@ -1,14 +1,26 @@
 package handler
 
 import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"spy_cats_agency/internal/domain"
+	"spy_cats_agency/internal/repository"
 	"spy_cats_agency/internal/service"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 )
 
+// maxImportRows caps the number of cats a single import may create.
+const maxImportRows = 1000
+
 // CatHandler handles the HTTP requests for cats.
 type CatHandler struct {
 	catService service.CatService
@@ -52,6 +64,69 @@ func (h *CatHandler) CreateCat(c *gin.Context) {
 	c.JSON(http.StatusCreated, cat)
 }
 
+// ImportCats handles creating many cats at once from a CSV file or a JSON array.
+// @Summary Import spy cats
+// @Description Creates cats from a JSON array of cats or a CSV file with a header row of name, years_of_experience, breed and salary. Each row is validated like a single cat. In atomic mode nothing is created unless every row is valid; in best_effort mode the valid rows are created. Responds 422 if no cat was created.
+// @Tags cats
+// @Accept json
+// @Accept text/csv
+// @Produce json
+// @Param cats body []CreateCatRequest true "Cats to create"
+// @Param mode query string false "Import mode (default atomic)" Enums(atomic, best_effort)
+// @Success 201 {object} domain.CatImportReport
+// @Failure 400 {object} ErrorResponse
+// @Failure 422 {object} domain.CatImportReport
+// @Failure 500 {object} ErrorResponse
+// @Router /cats/import [post]
+func (h *CatHandler) ImportCats(c *gin.Context) {
+	var query ImportCatsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, err.Error(), err))
+		return
+	}
+	mode := domain.ImportModeAtomic
+	if query.Mode != "" {
+		mode = domain.ImportMode(query.Mode)
+	}
+
+	var rows []domain.CatImportRow
+	var err error
+	switch c.ContentType() {
+	case "text/csv":
+		rows, err = parseCatsCSV(c.Request.Body)
+	case "application/json":
+		rows, err = parseCatsJSON(c.Request.Body)
+	default:
+		err = fmt.Errorf("unsupported content type %q, expected text/csv or application/json", c.ContentType())
+	}
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, err.Error(), err))
+		return
+	}
+	if len(rows) == 0 {
+		err := errors.New("no cats to import")
+		_ = c.Error(NewAppError(http.StatusBadRequest, err.Error(), err))
+		return
+	}
+	if len(rows) > maxImportRows {
+		err := fmt.Errorf("cannot import more than %d cats at once", maxImportRows)
+		_ = c.Error(NewAppError(http.StatusBadRequest, err.Error(), err))
+		return
+	}
+
+	report, err := h.catService.ImportCats(c.Request.Context(), rows, mode)
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	status := http.StatusCreated
+	if report.Created == 0 {
+		status = http.StatusUnprocessableEntity
+	}
+	c.JSON(status, report)
+}
+
 // GetCat handles retrieving a single cat by its ID.
 // @Summary Get a spy cat by ID
 // @Description Retrieves details of a specific spy cat.
@@ -78,27 +153,191 @@ func (h *CatHandler) GetCat(c *gin.Context) {
 	c.JSON(http.StatusOK, cat)
 }
 
-// ListCats handles listing all cats.
-// @Summary List all spy cats
-// @Description Retrieves a list of all spy cats in the system.
+// GetCatDossier handles retrieving a cat's dossier.
+// @Summary Get a spy cat's dossier
+// @Description Retrieves a spy cat together with its breed details and a picture of its breed from TheCatAPI.
+// @Tags cats
+// @Produce json
+// @Param id path int true "Cat ID"
+// @Success 200 {object} domain.CatDossier
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /cats/{id}/dossier [get]
+func (h *CatHandler) GetCatDossier(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid ID format", err))
+		return
+	}
+
+	dossier, err := h.catService.GetCatDossier(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusOK, dossier)
+}
+
+// GetCatStats handles retrieving a cat's performance statistics.
+// @Summary Get a spy cat's statistics
+// @Description Retrieves the missions assigned to and completed by a spy cat, its completed targets, the average time from assignment to completion and the countries it operated in.
+// @Tags cats
+// @Produce json
+// @Param id path int true "Cat ID"
+// @Success 200 {object} domain.CatStats
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /cats/{id}/stats [get]
+func (h *CatHandler) GetCatStats(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid ID format", err))
+		return
+	}
+
+	stats, err := h.catService.GetCatStats(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusOK, stats)
+}
+
+// GetLeaderboard handles ranking cats by their performance.
+// @Summary Get the spy cat leaderboard
+// @Description Ranks active spy cats by their performance statistics. Cats with the shortest average time to completion rank first when sorting by avg_completion_hours.
 // @Tags cats
 // @Produce json
-// @Success 200 {array} domain.Cat
+// @Param sort query string false "Ranking key (default missions_completed)" Enums(missions_completed, targets_completed, missions_assigned, avg_completion_hours)
+// @Param limit query int false "Number of cats (default 50, max 200)"
+// @Success 200 {array} domain.CatStats
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /cats/leaderboard [get]
+func (h *CatHandler) GetLeaderboard(c *gin.Context) {
+	var query LeaderboardQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, err.Error(), err))
+		return
+	}
+
+	stats, err := h.catService.GetLeaderboard(c.Request.Context(), query.Sort, query.Limit)
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusOK, stats)
+}
+
+// ListCats handles listing cats with filters, sorting and cursor pagination.
+// @Summary List spy cats
+// @Description Retrieves a page of spy cats. Pass the returned next_cursor as the cursor parameter to fetch the following page.
+// @Tags cats
+// @Produce json
+// @Param status query string false "Filter by status"
+// @Param breed query string false "Filter by breed"
+// @Param name query string false "Filter by a case-insensitive name substring"
+// @Param min_experience query int false "Minimum years of experience"
+// @Param max_experience query int false "Maximum years of experience"
+// @Param min_salary query number false "Minimum salary"
+// @Param max_salary query number false "Maximum salary"
+// @Param include_archived query bool false "Include retired cats"
+// @Param sort query string false "Sort key" Enums(created_at, name, years_of_experience, salary)
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Param cursor query string false "Pagination cursor"
+// @Param limit query int false "Page size (default 50, max 200)"
+// @Success 200 {object} domain.CatPage
+// @Failure 400 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /cats [get]
 func (h *CatHandler) ListCats(c *gin.Context) {
-	cats, err := h.catService.ListCats(c.Request.Context())
+	var query ListCatsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, err.Error(), err))
+		return
+	}
+
+	filter := domain.CatFilter{
+		Status:          domain.CatStatus(query.Status),
+		Breed:           query.Breed,
+		Name:            query.Name,
+		MinExperience:   query.MinExperience,
+		MaxExperience:   query.MaxExperience,
+		MinSalary:       query.MinSalary,
+		MaxSalary:       query.MaxSalary,
+		IncludeArchived: query.IncludeArchived,
+		SortBy:          query.Sort,
+		SortOrder:       query.Order,
+		Cursor:          query.Cursor,
+		Limit:           query.Limit,
+	}
+
+	page, err := h.catService.ListCats(c.Request.Context(), filter)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, repository.ErrInvalidCursor) {
+			status = http.StatusBadRequest
+		}
+		_ = c.Error(NewAppError(status, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+// UpdateCat handles partially updating a cat's profile.
+// @Summary Update a spy cat
+// @Description Updates any of a spy cat's name, years of experience, breed and salary. Omitted fields are left unchanged; a new breed must be valid according to TheCatAPI.
+// @Tags cats
+// @Accept json
+// @Produce json
+// @Param id path int true "Cat ID"
+// @Param cat body UpdateCatRequest true "Fields to update"
+// @Success 200 {object} domain.Cat
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /cats/{id} [patch]
+func (h *CatHandler) UpdateCat(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid ID format", err))
+		return
+	}
+
+	var req UpdateCatRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, err.Error(), err))
+		return
+	}
+	if req.Name == nil && req.YearsOfExperience == nil && req.Breed == nil && req.Salary == nil {
+		err := errors.New("at least one field must be provided")
+		_ = c.Error(NewAppError(http.StatusBadRequest, err.Error(), err))
+		return
+	}
+
+	update := domain.CatUpdate{
+		Name:              req.Name,
+		YearsOfExperience: req.YearsOfExperience,
+		Breed:             req.Breed,
+		Salary:            req.Salary,
+		SalaryReason:      req.SalaryReason,
+	}
+
+	cat, err := h.catService.UpdateCat(c.Request.Context(), id, update)
 	if err != nil {
-		_ = c.Error(NewAppError(http.StatusInternalServerError, "failed to list cats", err))
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
 		return
 	}
 
-	c.JSON(http.StatusOK, cats)
+	c.JSON(http.StatusOK, cat)
 }
 
 // UpdateCatSalary handles updating a cat's salary.
 // @Summary Update a spy cat's salary
-// @Description Updates the salary of a specific spy cat.
+// @Description Updates the salary of a specific spy cat and records the change in its salary history.
 // @Tags cats
 // @Accept json
 // @Produce json
@@ -121,7 +360,73 @@ func (h *CatHandler) UpdateCatSalary(c *gin.Context) {
 		return
 	}
 
-	cat, err := h.catService.UpdateCatSalary(c.Request.Context(), id, req.Salary)
+	change := domain.SalaryChange{NewSalary: req.Salary, Reason: req.Reason}
+	if req.EffectiveDate != "" {
+		// The format was already checked by the binding
+		change.EffectiveDate, _ = time.Parse("2006-01-02", req.EffectiveDate)
+	}
+
+	cat, err := h.catService.UpdateCatSalary(c.Request.Context(), id, change)
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusOK, cat)
+}
+
+// GetSalaryHistory handles retrieving a cat's salary history.
+// @Summary Get a spy cat's salary history
+// @Description Retrieves every salary change of a spy cat, starting with its starting salary.
+// @Tags cats
+// @Produce json
+// @Param id path int true "Cat ID"
+// @Success 200 {array} domain.SalaryChange
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /cats/{id}/salary-history [get]
+func (h *CatHandler) GetSalaryHistory(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid ID format", err))
+		return
+	}
+
+	history, err := h.catService.GetSalaryHistory(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusOK, history)
+}
+
+// ChangeCatStatus handles moving a cat to a new status.
+// @Summary Change a spy cat's status
+// @Description Moves a spy cat to a new status, such as on_leave, injured or suspended. Transitions that the status rules don't allow are rejected; mission assignment and retirement have their own endpoints.
+// @Tags cats
+// @Accept json
+// @Produce json
+// @Param id path int true "Cat ID"
+// @Param status body ChangeCatStatusRequest true "New status"
+// @Success 200 {object} domain.Cat
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /cats/{id}/status [post]
+func (h *CatHandler) ChangeCatStatus(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid ID format", err))
+		return
+	}
+
+	var req ChangeCatStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, err.Error(), err))
+		return
+	}
+
+	cat, err := h.catService.ChangeCatStatus(c.Request.Context(), id, domain.CatStatus(req.Status))
 	if err != nil {
 		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
 		return
@@ -130,26 +435,138 @@ func (h *CatHandler) UpdateCatSalary(c *gin.Context) {
 	c.JSON(http.StatusOK, cat)
 }
 
-// DeleteCat handles deleting a cat.
-// @Summary Delete a spy cat
-// @Description Removes a spy cat from the system.
+// RetireCat handles retiring a cat.
+// @Summary Retire a spy cat
+// @Description Retires and archives a spy cat. Retired cats are hidden from listings by default and can be restored. Cats on an active mission cannot be retired.
 // @Tags cats
 // @Param id path int true "Cat ID"
+// @Param reason query string false "Reason for the retirement"
 // @Success 204 "No Content"
 // @Failure 400 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /cats/{id} [delete]
-func (h *CatHandler) DeleteCat(c *gin.Context) {
+func (h *CatHandler) RetireCat(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
 		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid ID format", err))
 		return
 	}
 
-	if err := h.catService.DeleteCat(c.Request.Context(), id); err != nil {
+	if err := h.catService.RetireCat(c.Request.Context(), id, c.Query("reason")); err != nil {
 		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
 		return
 	}
 
 	c.Status(http.StatusNoContent)
 }
+
+// RestoreCat handles bringing a retired cat back into service.
+// @Summary Restore a retired spy cat
+// @Description Restores a retired spy cat and makes it available for missions again.
+// @Tags cats
+// @Produce json
+// @Param id path int true "Cat ID"
+// @Success 200 {object} domain.Cat
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /cats/{id}/restore [post]
+func (h *CatHandler) RestoreCat(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid ID format", err))
+		return
+	}
+
+	cat, err := h.catService.RestoreCat(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusOK, cat)
+}
+
+// parseCatsJSON reads a JSON array of cats into import rows. Rows that don't decode or
+// validate are reported individually rather than failing the whole import.
+func parseCatsJSON(r io.Reader) ([]domain.CatImportRow, error) {
+	var items []json.RawMessage
+	if err := json.NewDecoder(r).Decode(&items); err != nil {
+		return nil, fmt.Errorf("invalid JSON array: %w", err)
+	}
+
+	rows := make([]domain.CatImportRow, len(items))
+	for i, item := range items {
+		var req CreateCatRequest
+		err := json.Unmarshal(item, &req)
+		rows[i] = newCatImportRow(i+1, req, err)
+	}
+	return rows, nil
+}
+
+// parseCatsCSV reads a CSV file with a header row into import rows. The columns may
+// come in any order, but all of name, years_of_experience, breed and salary are required.
+func parseCatsCSV(r io.Reader) ([]domain.CatImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV header: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"name", "years_of_experience", "breed", "salary"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("CSV header is missing the %s column", name)
+		}
+	}
+
+	var rows []domain.CatImportRow
+	for n := 1; ; n++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			if _, ok := err.(*csv.ParseError); !ok {
+				return nil, err
+			}
+			rows = append(rows, domain.CatImportRow{Row: n, Error: err.Error()})
+			continue
+		}
+
+		req := CreateCatRequest{
+			Name:  record[columns["name"]],
+			Breed: record[columns["breed"]],
+		}
+		if req.YearsOfExperience, err = strconv.Atoi(record[columns["years_of_experience"]]); err != nil {
+			err = fmt.Errorf("invalid years_of_experience: %s", record[columns["years_of_experience"]])
+		} else if req.Salary, err = strconv.ParseFloat(record[columns["salary"]], 64); err != nil {
+			err = fmt.Errorf("invalid salary: %s", record[columns["salary"]])
+		}
+		rows = append(rows, newCatImportRow(n, req, err))
+	}
+	return rows, nil
+}
+
+// newCatImportRow validates a decoded row with the same rules as a single cat creation.
+func newCatImportRow(n int, req CreateCatRequest, err error) domain.CatImportRow {
+	if err == nil {
+		err = binding.Validator.ValidateStruct(&req)
+	}
+	if err != nil {
+		return domain.CatImportRow{Row: n, Error: err.Error()}
+	}
+
+	return domain.CatImportRow{
+		Row: n,
+		Cat: &domain.Cat{
+			Name:              req.Name,
+			YearsOfExperience: req.YearsOfExperience,
+			Breed:             req.Breed,
+			Salary:            req.Salary,
+		},
+	}
+}
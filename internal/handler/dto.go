@@ -1,5 +1,7 @@
 package handler
 
+import "time"
+
 // CreateCatRequest defines the request body for creating a cat.
 type CreateCatRequest struct {
 	Name              string  `json:"name" binding:"required"`
@@ -8,15 +10,150 @@ type CreateCatRequest struct {
 	Salary            float64 `json:"salary" binding:"required,gt=0"`
 }
 
+// ImportCatsQuery defines the query parameters of a cat import.
+type ImportCatsQuery struct {
+	Mode string `form:"mode" binding:"omitempty,oneof=atomic best_effort"` // Defaults to atomic
+}
+
+// UpdateCatRequest defines the request body for a partial cat update.
+// Omitted fields are left unchanged.
+type UpdateCatRequest struct {
+	Name              *string  `json:"name" binding:"omitempty,min=1"`
+	YearsOfExperience *int     `json:"years_of_experience" binding:"omitempty,gte=0"`
+	Breed             *string  `json:"breed" binding:"omitempty,min=1"`
+	Salary            *float64 `json:"salary" binding:"omitempty,gt=0"`
+	SalaryReason      string   `json:"salary_reason"`
+}
+
+// LeaderboardQuery defines the query parameters of the cat leaderboard.
+type LeaderboardQuery struct {
+	Sort  string `form:"sort" binding:"omitempty,oneof=missions_completed targets_completed missions_assigned avg_completion_hours"`
+	Limit int    `form:"limit" binding:"omitempty,min=1,max=200"`
+}
+
+// ChangeCatStatusRequest defines the request body for changing a cat's status.
+type ChangeCatStatusRequest struct {
+	Status string `json:"status" binding:"required,oneof=available on_mission on_leave injured suspended retired"`
+}
+
 // UpdateCatSalaryRequest defines the request body for updating a cat's salary.
 type UpdateCatSalaryRequest struct {
-	Salary float64 `json:"salary" binding:"required,gt=0"`
+	Salary        float64 `json:"salary" binding:"required,gt=0"`
+	Reason        string  `json:"reason"`
+	EffectiveDate string  `json:"effective_date" binding:"omitempty,datetime=2006-01-02"` // Defaults to today
+}
+
+// ListCatsQuery defines the query parameters for listing cats.
+type ListCatsQuery struct {
+	Status          string   `form:"status" binding:"omitempty,oneof=available on_mission on_leave injured suspended retired"`
+	Breed           string   `form:"breed"`
+	Name            string   `form:"name"`
+	MinExperience   *int     `form:"min_experience" binding:"omitempty,gte=0"`
+	MaxExperience   *int     `form:"max_experience" binding:"omitempty,gte=0"`
+	MinSalary       *float64 `form:"min_salary" binding:"omitempty,gte=0"`
+	MaxSalary       *float64 `form:"max_salary" binding:"omitempty,gte=0"`
+	IncludeArchived bool     `form:"include_archived"`
+	Sort            string   `form:"sort" binding:"omitempty,oneof=created_at name years_of_experience salary"`
+	Order           string   `form:"order" binding:"omitempty,oneof=asc desc"`
+	Cursor          string   `form:"cursor"`
+	Limit           int      `form:"limit" binding:"omitempty,min=1,max=200"`
+}
+
+// CreateSkillRequest defines the request body for adding a skill to the catalog.
+type CreateSkillRequest struct {
+	Name        string `json:"name" binding:"required"`
+	Description string `json:"description"`
+}
+
+// SetCatSkillRequest defines the request body for setting a cat's skill.
+type SetCatSkillRequest struct {
+	Proficiency int `json:"proficiency" binding:"required,min=1,max=5"`
+}
+
+// CreatePayrollRunRequest defines the request body for running payroll over a period.
+type CreatePayrollRunRequest struct {
+	PeriodStart  string   `json:"period_start" binding:"required,datetime=2006-01-02"`
+	PeriodEnd    string   `json:"period_end" binding:"required,datetime=2006-01-02"`
+	MissionBonus *float64 `json:"mission_bonus" binding:"omitempty,gte=0"` // Defaults to the configured bonus
+}
+
+// CreateLeaveRequest defines the request body for scheduling a cat's leave.
+type CreateLeaveRequest struct {
+	Kind      string `json:"kind" binding:"omitempty,oneof=leave training"` // Defaults to leave
+	StartDate string `json:"start_date" binding:"required,datetime=2006-01-02"`
+	EndDate   string `json:"end_date" binding:"required,datetime=2006-01-02"` // Inclusive
+	Reason    string `json:"reason"`
+}
+
+// AvailabilityQuery defines the query parameters of a cat's availability calendar.
+type AvailabilityQuery struct {
+	From string `form:"from" binding:"omitempty,datetime=2006-01-02"` // Defaults to today
+	To   string `form:"to" binding:"omitempty,datetime=2006-01-02"`   // Defaults to 30 days after from
 }
 
 // CreateMissionRequest represents the request to create a new mission.
 type CreateMissionRequest struct {
-	CatID   *int                  `json:"cat_id,omitempty"`
-	Targets []CreateTargetRequest `json:"targets" binding:"required,min=1,max=3,dive"`
+	CatID          *int                      `json:"cat_id,omitempty"`
+	Priority       int                       `json:"priority" binding:"omitempty,min=1,max=5"` // 1 is the most urgent, defaults to 3
+	Deadline       *time.Time                `json:"deadline,omitempty"`
+	Budget         *float64                  `json:"budget,omitempty" binding:"omitempty,min=0"` // Omit for unlimited spending
+	Targets        []CreateTargetRequest     `json:"targets" binding:"required,min=1,max=3,dive"`
+	RequiredSkills []SkillRequirementRequest `json:"required_skills" binding:"omitempty,dive"`
+}
+
+// CloneMissionRequest defines the options for cloning a mission.
+type CloneMissionRequest struct {
+	KeepNotes bool `json:"keep_notes"` // Copy the notes of the targets instead of resetting them
+}
+
+// ListMissionsQuery defines the query parameters of the mission listing.
+type ListMissionsQuery struct {
+	Completed   *bool  `form:"completed"`
+	CatID       *int   `form:"cat_id"`
+	Country     string `form:"country"`
+	CreatedFrom string `form:"created_from" binding:"omitempty,datetime=2006-01-02"`
+	CreatedTo   string `form:"created_to" binding:"omitempty,datetime=2006-01-02"` // Inclusive
+	Include     string `form:"include" binding:"omitempty,oneof=targets"`
+	Sort        string `form:"sort" binding:"omitempty,oneof=created_at priority deadline"`
+	Order       string `form:"order" binding:"omitempty,oneof=asc desc"`
+	Cursor      string `form:"cursor"`
+	Limit       int    `form:"limit" binding:"omitempty,min=1,max=200"`
+}
+
+// MissionTemplateRequest defines the request body for creating or replacing a mission template.
+type MissionTemplateRequest struct {
+	Name        string                `json:"name" binding:"required"`
+	Description string                `json:"description"`
+	Targets     []CreateTargetRequest `json:"targets" binding:"required,min=1,max=3,dive"`
+}
+
+// CreateMissionFromTemplateRequest defines the overrides applied to a mission created from a template.
+type CreateMissionFromTemplateRequest struct {
+	CatID          *int                      `json:"cat_id,omitempty"`
+	Priority       int                       `json:"priority" binding:"omitempty,min=1,max=5"` // 1 is the most urgent, defaults to 3
+	Deadline       *time.Time                `json:"deadline,omitempty"`
+	RequiredSkills []SkillRequirementRequest `json:"required_skills" binding:"omitempty,dive"`
+	Targets        []TargetOverrideRequest   `json:"targets" binding:"omitempty,dive"`
+}
+
+// TargetOverrideRequest defines replacement fields for the template target at a position.
+// Omitted fields keep the template's value.
+type TargetOverrideRequest struct {
+	Position int     `json:"position" binding:"required,min=1"`
+	Name     *string `json:"name,omitempty" binding:"omitempty,min=1"`
+	Country  *string `json:"country,omitempty" binding:"omitempty,min=1"`
+	Notes    *string `json:"notes,omitempty"`
+}
+
+// SkillRequirementRequest defines a skill a mission requires.
+type SkillRequirementRequest struct {
+	SkillID        int `json:"skill_id" binding:"required"`
+	MinProficiency int `json:"min_proficiency" binding:"required,min=1,max=5"`
+}
+
+// SetRequiredSkillsRequest defines the request body for replacing a mission's required skills.
+type SetRequiredSkillsRequest struct {
+	RequiredSkills []SkillRequirementRequest `json:"required_skills" binding:"dive"`
 }
 
 // CreateTargetRequest defines the structure for a target within a mission creation request.
@@ -32,15 +169,52 @@ type AssignCatRequest struct {
 }
 
 // UpdateMissionRequest defines the request body for updating a mission.
+// A null or omitted cat ID unassigns the current cat.
 type UpdateMissionRequest struct {
 	CatID *int `json:"cat_id,omitempty"`
 }
 
+// AddTeamMemberRequest defines the request body for adding a cat to a mission team.
+type AddTeamMemberRequest struct {
+	CatID int    `json:"cat_id" binding:"required"`
+	Role  string `json:"role" binding:"required,oneof=lead support handler_liaison"`
+}
+
+// BriefingQuery defines the query parameters of a mission briefing.
+type BriefingQuery struct {
+	Format string `form:"format" binding:"omitempty,oneof=markdown html"` // Defaults to markdown
+}
+
+// AddDependencyRequest defines the request body for adding a prerequisite to a mission.
+type AddDependencyRequest struct {
+	PrerequisiteID int `json:"prerequisite_id" binding:"required"`
+}
+
 // CompleteMissionRequest defines the request body for marking a mission as completed.
 type CompleteMissionRequest struct {
 	Completed bool `json:"completed" binding:"required"`
 }
 
+// ChangeMissionStatusRequest defines the request body for changing a mission's status.
+type ChangeMissionStatusRequest struct {
+	Status string `json:"status" binding:"required,oneof=draft assigned in_progress completed aborted failed"`
+}
+
+// SetBudgetRequest defines the request body for replacing a mission's budget.
+// A null or omitted budget removes the limit.
+type SetBudgetRequest struct {
+	Budget *float64 `json:"budget" binding:"omitempty,min=0"`
+}
+
+// CreateExpenseRequest defines the request body for recording a mission expense.
+type CreateExpenseRequest struct {
+	Amount      float64 `json:"amount" binding:"required,gt=0"`
+	Category    string  `json:"category" binding:"omitempty,oneof=travel lodging equipment informants other"` // Defaults to other
+	Date        string  `json:"date" binding:"omitempty,datetime=2006-01-02"`                                 // Defaults to today
+	Description string  `json:"description"`
+	Override    bool    `json:"override"` // Record the expense even if it exceeds the budget
+}
+
 // UpdateTargetNotesRequest defines the request body for updating a target's notes.
 type UpdateTargetNotesRequest struct {
 	Notes string `json:"notes" binding:"required"`
@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"net/http"
+	"spy_cats_agency/internal/domain"
+	"spy_cats_agency/internal/service"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ExpenseHandler handles the HTTP requests for mission budgets and expenses.
+type ExpenseHandler struct {
+	expenseService service.ExpenseService
+}
+
+// NewExpenseHandler creates a new ExpenseHandler.
+func NewExpenseHandler(expenseService service.ExpenseService) *ExpenseHandler {
+	return &ExpenseHandler{expenseService: expenseService}
+}
+
+// SetBudget handles replacing the budget of a mission.
+// @Summary Set a mission's budget
+// @Description Replaces the budget of a mission. A null budget removes the limit. Expenses that would exceed the budget are rejected unless they are recorded with an override.
+// @Tags missions
+// @Accept json
+// @Produce json
+// @Param id path int true "Mission ID"
+// @Param budget body SetBudgetRequest true "New budget"
+// @Success 200 {object} domain.Mission
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /missions/{id}/budget [put]
+func (h *ExpenseHandler) SetBudget(c *gin.Context) {
+	missionID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid ID format", err))
+		return
+	}
+
+	var req SetBudgetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, err.Error(), err))
+		return
+	}
+
+	mission, err := h.expenseService.SetBudget(c.Request.Context(), missionID, req.Budget)
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusOK, mission)
+}
+
+// RecordExpense handles recording an expense of a mission.
+// @Summary Record a mission expense
+// @Description Adds an expense to the ledger of a mission. An expense that would exceed the remaining budget is rejected unless override is set, in which case it is flagged as over budget.
+// @Tags missions
+// @Accept json
+// @Produce json
+// @Param id path int true "Mission ID"
+// @Param expense body CreateExpenseRequest true "Expense to record"
+// @Success 201 {object} domain.Expense
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /missions/{id}/expenses [post]
+func (h *ExpenseHandler) RecordExpense(c *gin.Context) {
+	missionID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid ID format", err))
+		return
+	}
+
+	var req CreateExpenseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, err.Error(), err))
+		return
+	}
+
+	expense := &domain.Expense{
+		MissionID:   missionID,
+		Amount:      req.Amount,
+		Category:    domain.ExpenseCategory(req.Category),
+		Description: req.Description,
+	}
+	if req.Date != "" {
+		// The format was already checked by the binding
+		expense.SpentOn, _ = time.Parse("2006-01-02", req.Date)
+	}
+
+	if err := h.expenseService.RecordExpense(c.Request.Context(), expense, req.Override); err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, expense)
+}
+
+// ListExpenses handles listing the expenses of a mission.
+// @Summary List a mission's expenses
+// @Description Retrieves the expense ledger of a mission in the order the money was spent.
+// @Tags missions
+// @Produce json
+// @Param id path int true "Mission ID"
+// @Success 200 {array} domain.Expense
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /missions/{id}/expenses [get]
+func (h *ExpenseHandler) ListExpenses(c *gin.Context) {
+	missionID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid ID format", err))
+		return
+	}
+
+	expenses, err := h.expenseService.ListExpenses(c.Request.Context(), missionID)
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusOK, expenses)
+}
+
+// GetFinance handles summarizing the costs of a mission.
+// @Summary Get a mission's finances
+// @Description Shows the money spent on a mission against its budget, broken down by category, and the salary of every team member prorated over the days it was on the team while the mission was running.
+// @Tags missions
+// @Produce json
+// @Param id path int true "Mission ID"
+// @Success 200 {object} domain.MissionFinance
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /missions/{id}/finance [get]
+func (h *ExpenseHandler) GetFinance(c *gin.Context) {
+	missionID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid ID format", err))
+		return
+	}
+
+	finance, err := h.expenseService.GetFinance(c.Request.Context(), missionID)
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusOK, finance)
+}
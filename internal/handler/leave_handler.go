@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"net/http"
+	"spy_cats_agency/internal/domain"
+	"spy_cats_agency/internal/service"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// LeaveHandler handles the HTTP requests for cat leave and availability.
+type LeaveHandler struct {
+	leaveService service.LeaveService
+}
+
+// NewLeaveHandler creates a new LeaveHandler.
+func NewLeaveHandler(leaveService service.LeaveService) *LeaveHandler {
+	return &LeaveHandler{leaveService: leaveService}
+}
+
+// ScheduleLeave handles scheduling a leave period for a cat.
+// @Summary Schedule leave for a spy cat
+// @Description Schedules a leave or training period. The cat is put on leave while the period lasts and becomes available again when it ends.
+// @Tags cats
+// @Accept json
+// @Produce json
+// @Param id path int true "Cat ID"
+// @Param leave body CreateLeaveRequest true "Leave period"
+// @Success 201 {object} domain.Leave
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /cats/{id}/leave [post]
+func (h *LeaveHandler) ScheduleLeave(c *gin.Context) {
+	catID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid ID format", err))
+		return
+	}
+
+	var req CreateLeaveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, err.Error(), err))
+		return
+	}
+
+	// The formats were already checked by the binding
+	startDate, _ := time.Parse("2006-01-02", req.StartDate)
+	endDate, _ := time.Parse("2006-01-02", req.EndDate)
+
+	leave := &domain.Leave{
+		CatID:     catID,
+		Kind:      domain.LeaveKind(req.Kind),
+		StartDate: startDate,
+		EndDate:   endDate,
+		Reason:    req.Reason,
+	}
+	if err := h.leaveService.ScheduleLeave(c.Request.Context(), leave); err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, leave)
+}
+
+// GetAvailability handles retrieving a cat's availability calendar.
+// @Summary Get a spy cat's availability
+// @Description Retrieves the leave periods of a spy cat within a date range and whether it can take a mission today.
+// @Tags cats
+// @Produce json
+// @Param id path int true "Cat ID"
+// @Param from query string false "Start of the range, YYYY-MM-DD (default today)"
+// @Param to query string false "End of the range, YYYY-MM-DD (default 30 days after from)"
+// @Success 200 {object} domain.CatAvailability
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /cats/{id}/availability [get]
+func (h *LeaveHandler) GetAvailability(c *gin.Context) {
+	catID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid ID format", err))
+		return
+	}
+
+	var query AvailabilityQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, err.Error(), err))
+		return
+	}
+
+	// The formats were already checked by the binding
+	from := time.Now()
+	if query.From != "" {
+		from, _ = time.Parse("2006-01-02", query.From)
+	}
+	to := from.AddDate(0, 0, 30)
+	if query.To != "" {
+		to, _ = time.Parse("2006-01-02", query.To)
+	}
+
+	availability, err := h.leaveService.GetAvailability(c.Request.Context(), catID, from, to)
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusOK, availability)
+}
@@ -5,12 +5,18 @@ import (
 	"github.com/gin-gonic/gin"
 	"log/slog"
 	"net/http"
+	"spy_cats_agency/internal/domain"
+	"spy_cats_agency/internal/service"
 )
 
+// anonymousActor is recorded for requests that don't name their actor.
+const anonymousActor = "anonymous"
+
 // ErrorResponse represents the structure of error responses
 type ErrorResponse struct {
-	Error string `json:"error"`
-	Code  int    `json:"code,omitempty"`
+	Error         string                    `json:"error"`
+	Code          int                       `json:"code,omitempty"`
+	MissingSkills []domain.SkillRequirement `json:"missing_skills,omitempty"` // Skills a cat lacks for a mission
 }
 
 type MessageResponse struct {
@@ -19,9 +25,10 @@ type MessageResponse struct {
 
 // AppError represents a custom application error
 type AppError struct {
-	Code         int    `json:"code"`
-	Message      string `json:"message"`
-	ErrorMessage error  `json:"error"`
+	Code          int                       `json:"code"`
+	Message       string                    `json:"message"`
+	ErrorMessage  error                     `json:"error"`
+	MissingSkills []domain.SkillRequirement `json:"missing_skills,omitempty"`
 }
 
 // Error implements the error interface
@@ -29,6 +36,19 @@ func (e *AppError) Error() string {
 	return e.Message
 }
 
+// NewMissionAppError creates an application error for a failed change of a mission. A cat
+// that lacks the skills the mission requires is rejected with 422 and the missing skills,
+// other errors get the given code.
+func NewMissionAppError(code int, err error) *AppError {
+	var skillsErr *service.MissingSkillsError
+	if errors.As(err, &skillsErr) {
+		appErr := NewAppError(http.StatusUnprocessableEntity, err.Error(), err)
+		appErr.MissingSkills = skillsErr.Missing
+		return appErr
+	}
+	return NewAppError(code, err.Error(), err)
+}
+
 // NewAppError creates a new custom application error
 func NewAppError(code int, message string, err error) *AppError {
 	return &AppError{
@@ -55,8 +75,9 @@ func ErrorMiddleware(logger *slog.Logger) gin.HandlerFunc {
 					slog.String("path", c.Request.URL.Path),
 				)
 				c.JSON(appErr.Code, ErrorResponse{
-					Code:  appErr.Code,
-					Error: appErr.Message,
+					Code:          appErr.Code,
+					Error:         appErr.Message,
+					MissingSkills: appErr.MissingSkills,
 				})
 				c.Abort()
 				return
@@ -71,3 +92,16 @@ func ErrorMiddleware(logger *slog.Logger) gin.HandlerFunc {
 		}
 	}
 }
+
+// ActorMiddleware attributes the changes made by a request to the actor named in its
+// X-Actor header, so they show up in mission timelines.
+func ActorMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		actor := c.GetHeader("X-Actor")
+		if actor == "" {
+			actor = anonymousActor
+		}
+		c.Request = c.Request.WithContext(service.WithActor(c.Request.Context(), actor))
+		c.Next()
+	}
+}
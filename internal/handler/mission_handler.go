@@ -1,33 +1,39 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
+	"spy_cats_agency/internal/briefing"
 	"spy_cats_agency/internal/domain"
+	"spy_cats_agency/internal/repository"
 	"spy_cats_agency/internal/service"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
 
 // MissionHandler handles the HTTP requests for missions.
 type MissionHandler struct {
-	missionService service.MissionService
+	missionService  service.MissionService
+	briefingService service.BriefingService
 }
 
 // NewMissionHandler creates a new MissionHandler.
-func NewMissionHandler(missionService service.MissionService) *MissionHandler {
-	return &MissionHandler{missionService: missionService}
+func NewMissionHandler(missionService service.MissionService, briefingService service.BriefingService) *MissionHandler {
+	return &MissionHandler{missionService: missionService, briefingService: briefingService}
 }
 
 // CreateMission handles the creation of a new mission.
 // @Summary Create a new mission
-// @Description Creates a new mission with 1 to 3 targets. Optionally assign a cat during creation.
+// @Description Creates a new mission with 1 to 3 targets and optional skill requirements. Optionally assign a cat during creation; it must have the required skills.
 // @Tags missions
 // @Accept json
 // @Produce json
 // @Param mission body CreateMissionRequest true "Mission to create"
 // @Success 201 {object} domain.Mission
 // @Failure 400 {object} ErrorResponse
+// @Failure 422 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /missions [post]
 func (h *MissionHandler) CreateMission(c *gin.Context) {
@@ -38,14 +44,18 @@ func (h *MissionHandler) CreateMission(c *gin.Context) {
 	}
 
 	mission := &domain.Mission{
-		CatID: req.CatID,
+		CatID:          req.CatID,
+		Priority:       req.Priority,
+		Deadline:       req.Deadline,
+		Budget:         req.Budget,
+		RequiredSkills: toSkillRequirements(req.RequiredSkills),
 	}
 	for _, t := range req.Targets {
 		mission.Targets = append(mission.Targets, domain.Target{Name: t.Name, Country: t.Country, Notes: t.Notes})
 	}
 
 	if err := h.missionService.CreateMission(c.Request.Context(), mission); err != nil {
-		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		_ = c.Error(NewMissionAppError(http.StatusInternalServerError, err))
 		return
 	}
 
@@ -78,18 +88,160 @@ func (h *MissionHandler) GetMission(c *gin.Context) {
 	c.JSON(http.StatusOK, mission)
 }
 
-// ListMissions handles listing all missions.
-// @Summary List all missions
-// @Description Retrieves a list of all missions.
+// GetMissionByCode handles retrieving a single mission by its reference code.
+// @Summary Get a mission by its reference code
+// @Description Retrieves details of a specific mission by its short reference code, ignoring case.
 // @Tags missions
 // @Produce json
-// @Success 200 {array} domain.Mission
+// @Param code path string true "Mission reference code"
+// @Success 200 {object} domain.Mission
+// @Failure 404 {object} ErrorResponse
+// @Router /missions/by-code/{code} [get]
+func (h *MissionHandler) GetMissionByCode(c *gin.Context) {
+	mission, err := h.missionService.GetMissionByCode(c.Request.Context(), c.Param("code"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, "Failed to get mission", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, mission)
+}
+
+// ListMissions handles listing missions with filters, sorting and cursor pagination.
+// @Summary List missions
+// @Description Retrieves a page of missions, newest first unless another sort order is requested. Missions without a deadline come after those with one. Pass the returned next_cursor as the cursor parameter to fetch the following page.
+// @Tags missions
+// @Produce json
+// @Param completed query bool false "Filter by completion"
+// @Param cat_id query int false "Filter by assigned cat"
+// @Param country query string false "Filter by the country of any target, case-insensitive"
+// @Param created_from query string false "Created on or after this date, YYYY-MM-DD"
+// @Param created_to query string false "Created on or before this date, YYYY-MM-DD"
+// @Param include query string false "Embed related data" Enums(targets)
+// @Param sort query string false "Sort key (default created_at)" Enums(created_at, priority, deadline)
+// @Param order query string false "Sort order (default desc for created_at, asc otherwise)" Enums(asc, desc)
+// @Param cursor query string false "Cursor from the previous page"
+// @Param limit query int false "Page size (default 50, max 200)"
+// @Success 200 {object} domain.MissionPage
+// @Failure 400 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /missions [get]
 func (h *MissionHandler) ListMissions(c *gin.Context) {
-	missions, err := h.missionService.ListMissions(c.Request.Context())
+	var query ListMissionsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, err.Error(), err))
+		return
+	}
+
+	filter := domain.MissionFilter{
+		Completed:      query.Completed,
+		CatID:          query.CatID,
+		Country:        query.Country,
+		IncludeTargets: query.Include == "targets",
+		SortBy:         query.Sort,
+		SortOrder:      query.Order,
+		Cursor:         query.Cursor,
+		Limit:          query.Limit,
+	}
+	// The formats were already checked by the binding
+	if query.CreatedFrom != "" {
+		from, _ := time.Parse("2006-01-02", query.CreatedFrom)
+		filter.CreatedFrom = &from
+	}
+	if query.CreatedTo != "" {
+		to, _ := time.Parse("2006-01-02", query.CreatedTo)
+		before := to.AddDate(0, 0, 1)
+		filter.CreatedBefore = &before
+	}
+
+	page, err := h.missionService.ListMissions(c.Request.Context(), filter)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, repository.ErrInvalidCursor) {
+			status = http.StatusBadRequest
+		}
+		_ = c.Error(NewAppError(status, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+// ListOverdueMissions handles listing the missions past their deadline.
+// @Summary List overdue missions
+// @Description Retrieves the missions that are not completed, aborted or failed although their deadline has passed, most overdue first.
+// @Tags missions
+// @Produce json
+// @Success 200 {array} domain.Mission
+// @Failure 500 {object} ErrorResponse
+// @Router /missions/overdue [get]
+func (h *MissionHandler) ListOverdueMissions(c *gin.Context) {
+	missions, err := h.missionService.ListOverdueMissions(c.Request.Context())
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusOK, missions)
+}
+
+// CloneMission handles copying a mission into a new one.
+// @Summary Clone a mission
+// @Description Copies a mission's targets, priority and required skills into a new unassigned draft mission that references the original. Target completion is cleared and notes are reset unless keep_notes is set. The deadline is not copied.
+// @Tags missions
+// @Accept json
+// @Produce json
+// @Param id path int true "Mission ID"
+// @Param options body CloneMissionRequest false "Clone options"
+// @Success 201 {object} domain.Mission
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /missions/{id}/clone [post]
+func (h *MissionHandler) CloneMission(c *gin.Context) {
+	missionID, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
-		_ = c.Error(NewAppError(http.StatusInternalServerError, "Failed to list missions", err))
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid mission ID format", err))
+		return
+	}
+
+	// The options are optional, so an empty body resets the notes
+	var req CloneMissionRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			_ = c.Error(NewAppError(http.StatusBadRequest, err.Error(), err))
+			return
+		}
+	}
+
+	mission, err := h.missionService.CloneMission(c.Request.Context(), missionID, req.KeepNotes)
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, mission)
+}
+
+// ListCatMissions handles listing the mission history of a cat.
+// @Summary List a spy cat's missions
+// @Description Retrieves the active and past missions a spy cat was assigned to, latest assignment first.
+// @Tags cats
+// @Produce json
+// @Param id path int true "Cat ID"
+// @Success 200 {array} domain.Mission
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /cats/{id}/missions [get]
+func (h *MissionHandler) ListCatMissions(c *gin.Context) {
+	catID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid ID format", err))
+		return
+	}
+
+	missions, err := h.missionService.ListCatMissions(c.Request.Context(), catID)
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
 		return
 	}
 
@@ -122,7 +274,7 @@ func (h *MissionHandler) DeleteMission(c *gin.Context) {
 
 // AssignCatToMission handles assigning a cat to a mission.
 // @Summary Assign a cat to a mission
-// @Description Assigns an available spy cat to an existing mission.
+// @Description Assigns an available spy cat to an existing mission, releasing the previously assigned cat. The cat must have the skills the mission requires.
 // @Tags missions
 // @Accept json
 // @Produce json
@@ -130,6 +282,7 @@ func (h *MissionHandler) DeleteMission(c *gin.Context) {
 // @Param cat body AssignCatRequest true "Cat to assign"
 // @Success 200 {object} MessageResponse
 // @Failure 400 {object} ErrorResponse
+// @Failure 422 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /missions/{id}/assign-cat [patch]
 func (h *MissionHandler) AssignCatToMission(c *gin.Context) {
@@ -146,16 +299,348 @@ func (h *MissionHandler) AssignCatToMission(c *gin.Context) {
 	}
 
 	if err := h.missionService.AssignCatToMission(c.Request.Context(), missionID, req.CatID); err != nil {
-		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		_ = c.Error(NewMissionAppError(http.StatusInternalServerError, err))
 		return
 	}
 
 	c.JSON(http.StatusOK, MessageResponse{Message: "Cat assigned successfully"})
 }
 
-// CompleteMission handles marking a mission as completed or uncompleted.
-// @Summary Complete/uncomplete a mission
-// @Description Manually marks a mission as completed or uncompleted.
+// ReassignCat handles swapping or clearing the cat assigned to a mission.
+// @Summary Reassign or unassign a mission's cat
+// @Description Swaps the cat assigned to a mission, or unassigns it when cat_id is null. The outgoing cat becomes available again and the incoming cat goes on the mission as the team lead; unassigning the cat releases the whole team. Missions that have ended cannot be reassigned, and missions in progress cannot be left without a cat.
+// @Tags missions
+// @Accept json
+// @Produce json
+// @Param id path int true "Mission ID"
+// @Param cat body UpdateMissionRequest true "Cat to assign"
+// @Success 200 {object} domain.Mission
+// @Failure 400 {object} ErrorResponse
+// @Failure 422 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /missions/{id}/cat [patch]
+func (h *MissionHandler) ReassignCat(c *gin.Context) {
+	missionID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid mission ID format", err))
+		return
+	}
+
+	var req UpdateMissionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, err.Error(), err))
+		return
+	}
+
+	mission, err := h.missionService.ReassignCat(c.Request.Context(), missionID, req.CatID)
+	if err != nil {
+		_ = c.Error(NewMissionAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, mission)
+}
+
+// AddTeamMember handles putting a cat on a mission team.
+// @Summary Add a cat to a mission team
+// @Description Puts a cat on the team of a mission in the given role. Adding a lead reassigns the mission's cat; support cats and handler liaisons can only join a mission that has a lead. The cat goes on the mission until it ends or the cat is removed.
+// @Tags missions
+// @Accept json
+// @Produce json
+// @Param id path int true "Mission ID"
+// @Param member body AddTeamMemberRequest true "Team member"
+// @Success 200 {object} domain.Mission
+// @Failure 400 {object} ErrorResponse
+// @Failure 422 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /missions/{id}/team [post]
+func (h *MissionHandler) AddTeamMember(c *gin.Context) {
+	missionID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid mission ID format", err))
+		return
+	}
+
+	var req AddTeamMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, err.Error(), err))
+		return
+	}
+
+	mission, err := h.missionService.AddTeamMember(c.Request.Context(), missionID, req.CatID, domain.TeamRole(req.Role))
+	if err != nil {
+		_ = c.Error(NewMissionAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, mission)
+}
+
+// RemoveTeamMember handles taking a cat off a mission team.
+// @Summary Remove a cat from a mission team
+// @Description Takes a cat off the team of a mission and makes it available again. Removing the lead unassigns the mission's cat and releases the whole team.
+// @Tags missions
+// @Produce json
+// @Param id path int true "Mission ID"
+// @Param catId path int true "Cat ID"
+// @Success 200 {object} domain.Mission
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /missions/{id}/team/{catId} [delete]
+func (h *MissionHandler) RemoveTeamMember(c *gin.Context) {
+	missionID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid mission ID format", err))
+		return
+	}
+	catID, err := strconv.Atoi(c.Param("catId"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid cat ID format", err))
+		return
+	}
+
+	mission, err := h.missionService.RemoveTeamMember(c.Request.Context(), missionID, catID)
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusOK, mission)
+}
+
+// GetBriefing handles rendering the briefing document of a mission.
+// @Summary Get a mission briefing
+// @Description Renders a briefing document from the mission, its targets and notes, its team and the assigned cat's profile and breed details.
+// @Tags missions
+// @Produce text/markdown
+// @Produce text/html
+// @Param id path int true "Mission ID"
+// @Param format query string false "Document format" Enums(markdown, html) default(markdown)
+// @Success 200 {string} string
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /missions/{id}/briefing [get]
+func (h *MissionHandler) GetBriefing(c *gin.Context) {
+	missionID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid mission ID format", err))
+		return
+	}
+
+	var query BriefingQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, err.Error(), err))
+		return
+	}
+	if query.Format == "" {
+		query.Format = briefing.FormatMarkdown
+	}
+
+	document, err := h.briefingService.RenderBriefing(c.Request.Context(), missionID, query.Format)
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	contentType := "text/markdown; charset=utf-8"
+	if query.Format == briefing.FormatHTML {
+		contentType = "text/html; charset=utf-8"
+	}
+	c.Data(http.StatusOK, contentType, document)
+}
+
+// GetTimeline handles retrieving the activity timeline of a mission.
+// @Summary Get a mission's timeline
+// @Description Retrieves everything that happened to a mission in order: creation, cat assignments, target changes, status changes and completion, each with the actor who made it and a kind-specific payload. Send the X-Actor header on changes to be named in their events.
+// @Tags missions
+// @Produce json
+// @Param id path int true "Mission ID"
+// @Success 200 {array} domain.MissionEvent
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /missions/{id}/timeline [get]
+func (h *MissionHandler) GetTimeline(c *gin.Context) {
+	missionID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid mission ID format", err))
+		return
+	}
+
+	events, err := h.missionService.GetTimeline(c.Request.Context(), missionID)
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusOK, events)
+}
+
+// GetDependencies handles retrieving the dependency graph of a mission.
+// @Summary Get a mission's dependencies
+// @Description Retrieves the missions a mission transitively waits for (upstream) and the missions transitively waiting for it (downstream), with every link between them.
+// @Tags missions
+// @Produce json
+// @Param id path int true "Mission ID"
+// @Success 200 {object} domain.MissionDependencyGraph
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /missions/{id}/dependencies [get]
+func (h *MissionHandler) GetDependencies(c *gin.Context) {
+	missionID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid mission ID format", err))
+		return
+	}
+
+	graph, err := h.missionService.GetDependencies(c.Request.Context(), missionID)
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusOK, graph)
+}
+
+// AddDependency handles adding a prerequisite to a mission.
+// @Summary Add a prerequisite to a mission
+// @Description Makes a mission wait for a prerequisite mission: it can't be assigned or started until the prerequisite is completed. Missions that have started can't get new prerequisites, and links that would create a cycle are rejected.
+// @Tags missions
+// @Accept json
+// @Produce json
+// @Param id path int true "Mission ID"
+// @Param dependency body AddDependencyRequest true "Prerequisite mission"
+// @Success 201 {object} domain.MissionDependencyGraph
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /missions/{id}/dependencies [post]
+func (h *MissionHandler) AddDependency(c *gin.Context) {
+	missionID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid mission ID format", err))
+		return
+	}
+
+	var req AddDependencyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, err.Error(), err))
+		return
+	}
+
+	graph, err := h.missionService.AddDependency(c.Request.Context(), missionID, req.PrerequisiteID)
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, graph)
+}
+
+// RemoveDependency handles removing a prerequisite from a mission.
+// @Summary Remove a prerequisite from a mission
+// @Description Removes a prerequisite, so that the mission no longer waits for it.
+// @Tags missions
+// @Produce json
+// @Param id path int true "Mission ID"
+// @Param prerequisiteId path int true "Prerequisite mission ID"
+// @Success 200 {object} domain.MissionDependencyGraph
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /missions/{id}/dependencies/{prerequisiteId} [delete]
+func (h *MissionHandler) RemoveDependency(c *gin.Context) {
+	missionID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid mission ID format", err))
+		return
+	}
+	prerequisiteID, err := strconv.Atoi(c.Param("prerequisiteId"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid prerequisite ID format", err))
+		return
+	}
+
+	graph, err := h.missionService.RemoveDependency(c.Request.Context(), missionID, prerequisiteID)
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusOK, graph)
+}
+
+// SetRequiredSkills handles replacing the skills a mission requires.
+// @Summary Set a mission's required skills
+// @Description Replaces the skills a mission requires. An already assigned cat must meet the new requirements.
+// @Tags missions
+// @Accept json
+// @Produce json
+// @Param id path int true "Mission ID"
+// @Param requirements body SetRequiredSkillsRequest true "Required skills"
+// @Success 200 {object} domain.Mission
+// @Failure 400 {object} ErrorResponse
+// @Failure 422 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /missions/{id}/required-skills [put]
+func (h *MissionHandler) SetRequiredSkills(c *gin.Context) {
+	missionID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "invalid id format", err))
+		return
+	}
+
+	var req SetRequiredSkillsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, err.Error(), err))
+		return
+	}
+
+	mission, err := h.missionService.SetRequiredSkills(c.Request.Context(), missionID, toSkillRequirements(req.RequiredSkills))
+	if err != nil {
+		_ = c.Error(NewMissionAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, mission)
+}
+
+// ListCandidates handles ranking the cats that could be assigned to a mission.
+// @Summary List candidate cats for a mission
+// @Description Ranks the available cats that meet a mission's skill requirements. The score combines years of experience, targets already completed in the mission's countries and current workload, and each candidate comes with an explanation of its score.
+// @Tags missions
+// @Produce json
+// @Param id path int true "Mission ID"
+// @Param limit query int false "Maximum number of candidates (default 10)"
+// @Success 200 {array} domain.MissionCandidate
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /missions/{id}/candidates [get]
+func (h *MissionHandler) ListCandidates(c *gin.Context) {
+	missionID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "invalid id format", err))
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	if err == nil && limit < 1 {
+		err = errors.New("limit must be positive")
+	}
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "limit must be a positive number", err))
+		return
+	}
+
+	candidates, err := h.missionService.ListCandidates(c.Request.Context(), missionID, limit)
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusOK, candidates)
+}
+
+// CompleteMission handles marking a mission as completed.
+// @Summary Complete a mission
+// @Description Manually marks a mission in progress as completed. Completed missions cannot be reopened.
 // @Tags missions
 // @Accept json
 // @Produce json
@@ -187,3 +672,46 @@ func (h *MissionHandler) CompleteMission(c *gin.Context) {
 
 	c.JSON(http.StatusOK, mission)
 }
+
+// ChangeMissionStatus handles moving a mission to a new status.
+// @Summary Change a mission's status
+// @Description Moves a mission along its lifecycle, e.g. from in_progress to completed, aborted or failed. Transitions that the status rules don't allow are rejected; missions become assigned or return to draft through their cat.
+// @Tags missions
+// @Accept json
+// @Produce json
+// @Param id path int true "Mission ID"
+// @Param status body ChangeMissionStatusRequest true "New status"
+// @Success 200 {object} domain.Mission
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /missions/{id}/status [post]
+func (h *MissionHandler) ChangeMissionStatus(c *gin.Context) {
+	missionID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid mission ID format", err))
+		return
+	}
+
+	var req ChangeMissionStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, err.Error(), err))
+		return
+	}
+
+	mission, err := h.missionService.ChangeMissionStatus(c.Request.Context(), missionID, domain.MissionStatus(req.Status))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusOK, mission)
+}
+
+// toSkillRequirements converts requested skill requirements to their domain form.
+func toSkillRequirements(reqs []SkillRequirementRequest) []domain.SkillRequirement {
+	requirements := make([]domain.SkillRequirement, 0, len(reqs))
+	for _, r := range reqs {
+		requirements = append(requirements, domain.SkillRequirement{SkillID: r.SkillID, MinProficiency: r.MinProficiency})
+	}
+	return requirements
+}
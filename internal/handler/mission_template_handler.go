@@ -0,0 +1,212 @@
+package handler
+
+import (
+	"net/http"
+	"spy_cats_agency/internal/domain"
+	"spy_cats_agency/internal/service"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MissionTemplateHandler handles the HTTP requests for mission templates.
+type MissionTemplateHandler struct {
+	templateService service.MissionTemplateService
+}
+
+// NewMissionTemplateHandler creates a new MissionTemplateHandler.
+func NewMissionTemplateHandler(templateService service.MissionTemplateService) *MissionTemplateHandler {
+	return &MissionTemplateHandler{templateService: templateService}
+}
+
+// CreateTemplate handles saving a new mission template.
+// @Summary Create a mission template
+// @Description Saves a named template holding 1 to 3 target skeletons that missions can be created from.
+// @Tags mission-templates
+// @Accept json
+// @Produce json
+// @Param template body MissionTemplateRequest true "Template to create"
+// @Success 201 {object} domain.MissionTemplate
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /mission-templates [post]
+func (h *MissionTemplateHandler) CreateTemplate(c *gin.Context) {
+	var req MissionTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, err.Error(), err))
+		return
+	}
+
+	template := toMissionTemplate(req)
+	if err := h.templateService.CreateTemplate(c.Request.Context(), template); err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, template)
+}
+
+// GetTemplate handles retrieving a mission template by its ID.
+// @Summary Get a mission template by ID
+// @Description Retrieves a mission template including its target skeletons.
+// @Tags mission-templates
+// @Produce json
+// @Param id path int true "Template ID"
+// @Success 200 {object} domain.MissionTemplate
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /mission-templates/{id} [get]
+func (h *MissionTemplateHandler) GetTemplate(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid ID format", err))
+		return
+	}
+
+	template, err := h.templateService.GetTemplate(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusOK, template)
+}
+
+// ListTemplates handles listing all mission templates.
+// @Summary List mission templates
+// @Description Retrieves all mission templates without their targets, by name.
+// @Tags mission-templates
+// @Produce json
+// @Success 200 {array} domain.MissionTemplate
+// @Failure 500 {object} ErrorResponse
+// @Router /mission-templates [get]
+func (h *MissionTemplateHandler) ListTemplates(c *gin.Context) {
+	templates, err := h.templateService.ListTemplates(c.Request.Context())
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusOK, templates)
+}
+
+// UpdateTemplate handles replacing a mission template.
+// @Summary Replace a mission template
+// @Description Replaces the name, description and target skeletons of a mission template. Missions already created from it are not affected.
+// @Tags mission-templates
+// @Accept json
+// @Produce json
+// @Param id path int true "Template ID"
+// @Param template body MissionTemplateRequest true "New template content"
+// @Success 200 {object} domain.MissionTemplate
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /mission-templates/{id} [put]
+func (h *MissionTemplateHandler) UpdateTemplate(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid ID format", err))
+		return
+	}
+
+	var req MissionTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, err.Error(), err))
+		return
+	}
+
+	template := toMissionTemplate(req)
+	template.ID = id
+	if err := h.templateService.UpdateTemplate(c.Request.Context(), template); err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusOK, template)
+}
+
+// DeleteTemplate handles deleting a mission template.
+// @Summary Delete a mission template
+// @Description Deletes a mission template. Missions already created from it are kept.
+// @Tags mission-templates
+// @Param id path int true "Template ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /mission-templates/{id} [delete]
+func (h *MissionTemplateHandler) DeleteTemplate(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid ID format", err))
+		return
+	}
+
+	if err := h.templateService.DeleteTemplate(c.Request.Context(), id); err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// CreateMissionFromTemplate handles creating a mission from a template.
+// @Summary Create a mission from a template
+// @Description Creates a mission with the targets of a template. Fields of individual targets as well as the cat, priority, deadline and required skills can be overridden. The mission is validated like any other new mission.
+// @Tags missions
+// @Accept json
+// @Produce json
+// @Param id path int true "Template ID"
+// @Param overrides body CreateMissionFromTemplateRequest false "Overrides"
+// @Success 201 {object} domain.Mission
+// @Failure 400 {object} ErrorResponse
+// @Failure 422 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /missions/from-template/{id} [post]
+func (h *MissionTemplateHandler) CreateMissionFromTemplate(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid ID format", err))
+		return
+	}
+
+	// The overrides are optional, so an empty body creates the mission as templated
+	var req CreateMissionFromTemplateRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			_ = c.Error(NewAppError(http.StatusBadRequest, err.Error(), err))
+			return
+		}
+	}
+
+	overrides := domain.MissionOverrides{
+		CatID:          req.CatID,
+		Priority:       req.Priority,
+		Deadline:       req.Deadline,
+		RequiredSkills: toSkillRequirements(req.RequiredSkills),
+	}
+	for _, t := range req.Targets {
+		overrides.Targets = append(overrides.Targets, domain.TargetOverride{
+			Position: t.Position,
+			Name:     t.Name,
+			Country:  t.Country,
+			Notes:    t.Notes,
+		})
+	}
+
+	mission, err := h.templateService.CreateMissionFromTemplate(c.Request.Context(), id, overrides)
+	if err != nil {
+		_ = c.Error(NewMissionAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, mission)
+}
+
+// toMissionTemplate converts a template request to its domain form.
+func toMissionTemplate(req MissionTemplateRequest) *domain.MissionTemplate {
+	template := &domain.MissionTemplate{Name: req.Name, Description: req.Description}
+	for _, t := range req.Targets {
+		template.Targets = append(template.Targets, domain.TemplateTarget{Name: t.Name, Country: t.Country, Notes: t.Notes})
+	}
+	return template
+}
@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"spy_cats_agency/internal/service"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PayrollHandler handles the HTTP requests for payroll runs.
+type PayrollHandler struct {
+	payrollService service.PayrollService
+}
+
+// NewPayrollHandler creates a new PayrollHandler.
+func NewPayrollHandler(payrollService service.PayrollService) *PayrollHandler {
+	return &PayrollHandler{payrollService: payrollService}
+}
+
+// CreatePayrollRun handles running payroll for a period.
+// @Summary Run payroll for a period
+// @Description Calculates prorated pay and mission completion bonuses for every cat over an inclusive period of at most 366 days and stores the result as an immutable payroll run.
+// @Tags payroll
+// @Accept json
+// @Produce json
+// @Param run body CreatePayrollRunRequest true "Payroll period"
+// @Success 201 {object} domain.PayrollRun
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /payroll/runs [post]
+func (h *PayrollHandler) CreatePayrollRun(c *gin.Context) {
+	var req CreatePayrollRunRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, err.Error(), err))
+		return
+	}
+
+	// The formats were already checked by the binding
+	periodStart, _ := time.Parse("2006-01-02", req.PeriodStart)
+	periodEnd, _ := time.Parse("2006-01-02", req.PeriodEnd)
+
+	run, err := h.payrollService.CreatePayrollRun(c.Request.Context(), periodStart, periodEnd, req.MissionBonus)
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, run)
+}
+
+// GetPayrollRun handles retrieving a payroll run by its ID.
+// @Summary Get a payroll run by ID
+// @Description Retrieves a payroll run including the pay of every cat.
+// @Tags payroll
+// @Produce json
+// @Param id path int true "Payroll run ID"
+// @Success 200 {object} domain.PayrollRun
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /payroll/runs/{id} [get]
+func (h *PayrollHandler) GetPayrollRun(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid ID format", err))
+		return
+	}
+
+	run, err := h.payrollService.GetPayrollRun(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusOK, run)
+}
+
+// ListPayrollRuns handles listing all payroll runs.
+// @Summary List payroll runs
+// @Description Retrieves all payroll runs without their entries, latest period first.
+// @Tags payroll
+// @Produce json
+// @Success 200 {array} domain.PayrollRun
+// @Failure 500 {object} ErrorResponse
+// @Router /payroll/runs [get]
+func (h *PayrollHandler) ListPayrollRuns(c *gin.Context) {
+	runs, err := h.payrollService.ListPayrollRuns(c.Request.Context())
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusOK, runs)
+}
+
+// ExportPayrollRun handles exporting a payroll run as CSV.
+// @Summary Export a payroll run as CSV
+// @Description Downloads the entries of a payroll run as a CSV file with one row per cat.
+// @Tags payroll
+// @Produce text/csv
+// @Param id path int true "Payroll run ID"
+// @Success 200 {file} file
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /payroll/runs/{id}/export [get]
+func (h *PayrollHandler) ExportPayrollRun(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid ID format", err))
+		return
+	}
+
+	run, err := h.payrollService.GetPayrollRun(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.Header("Content-Type", "text/csv")
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="payroll-run-%d.csv"`, run.ID))
+
+	w := csv.NewWriter(c.Writer)
+	_ = w.Write([]string{"run_id", "period_start", "period_end", "cat_id", "cat_name", "days_worked", "base_pay", "missions_completed", "bonus", "total"})
+	for _, e := range run.Entries {
+		_ = w.Write([]string{
+			strconv.Itoa(run.ID),
+			run.PeriodStart.Format("2006-01-02"),
+			run.PeriodEnd.Format("2006-01-02"),
+			strconv.Itoa(e.CatID),
+			e.CatName,
+			strconv.Itoa(e.DaysWorked),
+			strconv.FormatFloat(e.BasePay, 'f', 2, 64),
+			strconv.Itoa(e.MissionsCompleted),
+			strconv.FormatFloat(e.Bonus, 'f', 2, 64),
+			strconv.FormatFloat(e.Total, 'f', 2, 64),
+		})
+	}
+	w.Flush()
+}
@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"net/http"
+	"spy_cats_agency/internal/domain"
+	"spy_cats_agency/internal/service"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SkillHandler handles the HTTP requests for skills.
+type SkillHandler struct {
+	skillService service.SkillService
+}
+
+// NewSkillHandler creates a new SkillHandler.
+func NewSkillHandler(skillService service.SkillService) *SkillHandler {
+	return &SkillHandler{skillService: skillService}
+}
+
+// CreateSkill handles adding a skill to the catalog.
+// @Summary Create a skill
+// @Description Adds a new skill, such as infiltration or surveillance, to the skill catalog.
+// @Tags skills
+// @Accept json
+// @Produce json
+// @Param skill body CreateSkillRequest true "Skill to create"
+// @Success 201 {object} domain.Skill
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /skills [post]
+func (h *SkillHandler) CreateSkill(c *gin.Context) {
+	var req CreateSkillRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, err.Error(), err))
+		return
+	}
+
+	skill := &domain.Skill{Name: req.Name, Description: req.Description}
+	if err := h.skillService.CreateSkill(c.Request.Context(), skill); err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, skill)
+}
+
+// ListSkills handles listing the skill catalog.
+// @Summary List skills
+// @Description Retrieves the skill catalog.
+// @Tags skills
+// @Produce json
+// @Success 200 {array} domain.Skill
+// @Failure 500 {object} ErrorResponse
+// @Router /skills [get]
+func (h *SkillHandler) ListSkills(c *gin.Context) {
+	skills, err := h.skillService.ListSkills(c.Request.Context())
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusOK, skills)
+}
+
+// ListCatSkills handles listing the skills of a cat.
+// @Summary List a spy cat's skills
+// @Description Retrieves the skills of a spy cat with their proficiency levels.
+// @Tags cats
+// @Produce json
+// @Param id path int true "Cat ID"
+// @Success 200 {array} domain.CatSkill
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /cats/{id}/skills [get]
+func (h *SkillHandler) ListCatSkills(c *gin.Context) {
+	catID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid ID format", err))
+		return
+	}
+
+	skills, err := h.skillService.ListCatSkills(c.Request.Context(), catID)
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusOK, skills)
+}
+
+// SetCatSkill handles giving a skill to a cat.
+// @Summary Set a spy cat's skill
+// @Description Gives a spy cat a skill from the catalog, or changes the proficiency of a skill it already has.
+// @Tags cats
+// @Accept json
+// @Produce json
+// @Param id path int true "Cat ID"
+// @Param skillId path int true "Skill ID"
+// @Param skill body SetCatSkillRequest true "Proficiency level"
+// @Success 200 {array} domain.CatSkill
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /cats/{id}/skills/{skillId} [put]
+func (h *SkillHandler) SetCatSkill(c *gin.Context) {
+	catID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid ID format", err))
+		return
+	}
+	skillID, err := strconv.Atoi(c.Param("skillId"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid skill ID format", err))
+		return
+	}
+
+	var req SetCatSkillRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, err.Error(), err))
+		return
+	}
+
+	skills, err := h.skillService.SetCatSkill(c.Request.Context(), catID, skillID, req.Proficiency)
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.JSON(http.StatusOK, skills)
+}
+
+// RemoveCatSkill handles taking a skill away from a cat.
+// @Summary Remove a spy cat's skill
+// @Description Removes a skill from a spy cat.
+// @Tags cats
+// @Param id path int true "Cat ID"
+// @Param skillId path int true "Skill ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /cats/{id}/skills/{skillId} [delete]
+func (h *SkillHandler) RemoveCatSkill(c *gin.Context) {
+	catID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid ID format", err))
+		return
+	}
+	skillID, err := strconv.Atoi(c.Param("skillId"))
+	if err != nil {
+		_ = c.Error(NewAppError(http.StatusBadRequest, "Invalid skill ID format", err))
+		return
+	}
+
+	if err := h.skillService.RemoveCatSkill(c.Request.Context(), catID, skillID); err != nil {
+		_ = c.Error(NewAppError(http.StatusInternalServerError, err.Error(), err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -89,7 +89,7 @@ func (h *TargetHandler) UpdateTargetNotes(c *gin.Context) {
 
 // CompleteTarget handles marking a target as complete.
 // @Summary Complete a target
-// @Description Marks a target as complete. If all targets in the mission are complete, the mission is also marked as complete.
+// @Description Marks a target as complete; targets that are already complete are rejected. If all targets in the mission are complete, the mission is also marked as complete.
 // @Tags targets
 // @Param id path int true "Target ID"
 // @Success 200 {object} domain.Target
@@ -114,7 +114,7 @@ func (h *TargetHandler) CompleteTarget(c *gin.Context) {
 
 // DeleteTarget handles deleting a target from a mission.
 // @Summary Delete a target
-// @Description Deletes a target from a mission if it is not yet completed.
+// @Description Deletes a target from a mission if it is not yet completed and the mission hasn't ended. The last open target of a mission in progress cannot be deleted; complete it instead.
 // @Tags targets
 // @Param id path int true "Target ID"
 // @Success 204 "No Content"
@@ -2,10 +2,19 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
 	"spy_cats_agency/internal/domain"
 	"spy_cats_agency/internal/repository"
+	"strconv"
+	"time"
+
+	"github.com/lib/pq"
 )
 
+// catColumns lists the columns selected into domain.Cat.
+const catColumns = `id, name, years_of_experience, breed, salary, status, archived_at, archive_reason, created_at, updated_at`
+
 // CatRepository implements the repository.CatRepository interface.
 type CatRepository struct {
 	db *DB
@@ -16,20 +25,58 @@ func NewCatRepository(db *DB) repository.CatRepository {
 	return &CatRepository{db: db}
 }
 
-// CreateCat creates a new cat in the database.
+// CreateCat creates a new cat and records its starting salary within a transaction.
 func (r *CatRepository) CreateCat(ctx context.Context, cat *domain.Cat) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := insertCat(ctx, tx, cat); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// CreateCats inserts several cats within a single transaction, so either all of them
+// are created or none is.
+func (r *CatRepository) CreateCats(ctx context.Context, cats []*domain.Cat) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, cat := range cats {
+		if err := insertCat(ctx, tx, cat); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// insertCat inserts a cat and records its starting salary in the salary history.
+func insertCat(ctx context.Context, tx *Tx, cat *domain.Cat) error {
 	query := `INSERT INTO cats (name, years_of_experience, breed, salary)
 			  VALUES ($1, $2, $3, $4)
 			  RETURNING id, created_at, updated_at, status`
-	return r.db.QueryRowxContext(ctx, query, cat.Name, cat.YearsOfExperience, cat.Breed, cat.Salary).
+	err := tx.QueryRowxContext(ctx, query, cat.Name, cat.YearsOfExperience, cat.Breed, cat.Salary).
 		Scan(&cat.ID, &cat.CreatedAt, &cat.UpdatedAt, &cat.Status)
+	if err != nil {
+		return err
+	}
+
+	change := &domain.SalaryChange{CatID: cat.ID, NewSalary: cat.Salary, Reason: "starting salary"}
+	return insertSalaryChange(ctx, tx, change)
 }
 
 // GetCatByID retrieves a cat by its ID.
 func (r *CatRepository) GetCatByID(ctx context.Context, id int) (*domain.Cat, error) {
 	var cat domain.Cat
-	query := `SELECT id, name, years_of_experience, breed, salary, status, created_at, updated_at
-			  FROM cats WHERE id = $1`
+	query := `SELECT ` + catColumns + ` FROM cats WHERE id = $1`
 	err := r.db.GetContext(ctx, &cat, query, id)
 	if err != nil {
 		return nil, err
@@ -37,24 +84,331 @@ func (r *CatRepository) GetCatByID(ctx context.Context, id int) (*domain.Cat, er
 	return &cat, nil
 }
 
-// ListCats retrieves all cats from the database.
-func (r *CatRepository) ListCats(ctx context.Context) ([]domain.Cat, error) {
-	var cats []domain.Cat
-	query := `SELECT id, name, years_of_experience, breed, salary, status, created_at, updated_at
-			  FROM cats ORDER BY created_at DESC`
-	err := r.db.SelectContext(ctx, &cats, query)
-	return cats, err
+// catSortColumns maps the sort keys of domain.CatFilter to their columns.
+var catSortColumns = map[string]struct {
+	sortColumn
+	value func(cat domain.Cat) string
+}{
+	domain.CatSortCreatedAt: {
+		sortColumn{expr: "created_at", cast: "timestamptz", defaultDesc: true},
+		func(cat domain.Cat) string { return cat.CreatedAt.Format(time.RFC3339Nano) },
+	},
+	domain.CatSortName: {
+		sortColumn{expr: "name", cast: "varchar"},
+		func(cat domain.Cat) string { return cat.Name },
+	},
+	domain.CatSortYearsOfExperience: {
+		sortColumn{expr: "years_of_experience", cast: "int"},
+		func(cat domain.Cat) string { return strconv.Itoa(cat.YearsOfExperience) },
+	},
+	domain.CatSortSalary: {
+		sortColumn{expr: "salary", cast: "decimal"},
+		func(cat domain.Cat) string { return strconv.FormatFloat(cat.Salary, 'f', -1, 64) },
+	},
+}
+
+// ListCats retrieves a page of cats matching the filter.
+func (r *CatRepository) ListCats(ctx context.Context, filter domain.CatFilter) (*domain.CatPage, error) {
+	sortBy := filter.SortBy
+	if sortBy == "" {
+		sortBy = domain.CatSortCreatedAt
+	}
+	sort, ok := catSortColumns[sortBy]
+	if !ok {
+		return nil, fmt.Errorf("invalid sort key: %s", sortBy)
+	}
+	desc, err := sortDesc(sort.sortColumn, filter.SortOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	var b whereBuilder
+	if !filter.IncludeArchived {
+		b.add("archived_at IS NULL")
+	}
+	if filter.Status != "" {
+		b.add("status = " + b.arg(filter.Status))
+	}
+	if filter.Breed != "" {
+		b.add("breed = " + b.arg(filter.Breed))
+	}
+	if filter.Name != "" {
+		b.add("name ILIKE " + b.arg(likePattern(filter.Name)))
+	}
+	if filter.MinExperience != nil {
+		b.add("years_of_experience >= " + b.arg(*filter.MinExperience))
+	}
+	if filter.MaxExperience != nil {
+		b.add("years_of_experience <= " + b.arg(*filter.MaxExperience))
+	}
+	if filter.MinSalary != nil {
+		b.add("salary >= " + b.arg(*filter.MinSalary))
+	}
+	if filter.MaxSalary != nil {
+		b.add("salary <= " + b.arg(*filter.MaxSalary))
+	}
+
+	orderBy, err := applyKeyset(&b, sort.sortColumn, sortBy, desc, filter.Cursor, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch one extra row to find out whether another page follows.
+	limit := pageLimit(filter.Limit)
+	query := `SELECT ` + catColumns + ` FROM cats` + b.clause() + orderBy + fmt.Sprintf(" LIMIT %d", limit+1)
+
+	cats := []domain.Cat{}
+	if err := r.db.SelectContext(ctx, &cats, query, b.args...); err != nil {
+		return nil, err
+	}
+
+	page := &domain.CatPage{Cats: cats}
+	if len(cats) > limit {
+		page.Cats = cats[:limit]
+		last := page.Cats[limit-1]
+		page.NextCursor = encodeCursor(cursor{SortBy: sortBy, Desc: desc, Value: sort.value(last), ID: last.ID})
+	}
+	return page, nil
 }
 
-// UpdateCat updates a cat's information.
-func (r *CatRepository) UpdateCat(ctx context.Context, cat *domain.Cat) error {
-	query := `UPDATE cats SET salary = $1, updated_at = now() WHERE id = $2 RETURNING updated_at`
-	return r.db.QueryRowxContext(ctx, query, cat.Salary, cat.ID).Scan(&cat.UpdatedAt)
+// UpdateCat updates a cat's profile and salary. If the salary differs from the stored
+// one, the change is appended to the salary history in the same transaction, using the
+// reason and effective date of salaryChange (nil records it effective today).
+func (r *CatRepository) UpdateCat(ctx context.Context, cat *domain.Cat, salaryChange *domain.SalaryChange) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Lock the row so concurrent updates can't record the same old salary twice
+	var oldSalary float64
+	err = tx.QueryRowxContext(ctx, `SELECT salary FROM cats WHERE id = $1 FOR UPDATE`, cat.ID).Scan(&oldSalary)
+	if err != nil {
+		return err
+	}
+
+	query := `UPDATE cats SET name = $1, years_of_experience = $2, breed = $3, salary = $4, updated_at = now()
+			  WHERE id = $5 RETURNING updated_at`
+	err = tx.QueryRowxContext(ctx, query, cat.Name, cat.YearsOfExperience, cat.Breed, cat.Salary, cat.ID).
+		Scan(&cat.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	if cat.Salary != oldSalary {
+		if salaryChange == nil {
+			salaryChange = &domain.SalaryChange{}
+		}
+		salaryChange.CatID = cat.ID
+		salaryChange.OldSalary = &oldSalary
+		salaryChange.NewSalary = cat.Salary
+		if err := insertSalaryChange(ctx, tx, salaryChange); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
 }
 
-// DeleteCat removes a cat from the database.
-func (r *CatRepository) DeleteCat(ctx context.Context, id int) error {
-	query := `DELETE FROM cats WHERE id = $1`
-	_, err := r.db.ExecContext(ctx, query, id)
+// ListSalaryChanges retrieves a cat's salary history, oldest first.
+func (r *CatRepository) ListSalaryChanges(ctx context.Context, catID int) ([]domain.SalaryChange, error) {
+	changes := []domain.SalaryChange{}
+	query := `SELECT id, cat_id, old_salary, new_salary, effective_date, reason, created_at
+			  FROM cat_salary_changes WHERE cat_id = $1 ORDER BY effective_date, id`
+	err := r.db.SelectContext(ctx, &changes, query, catID)
+	return changes, err
+}
+
+// ListSalaryChangesUntil retrieves the salary history entries of all cats that take
+// effect on or before the given date, ordered by cat and effective date.
+func (r *CatRepository) ListSalaryChangesUntil(ctx context.Context, until time.Time) ([]domain.SalaryChange, error) {
+	changes := []domain.SalaryChange{}
+	query := `SELECT id, cat_id, old_salary, new_salary, effective_date, reason, created_at
+			  FROM cat_salary_changes WHERE effective_date <= $1::date ORDER BY cat_id, effective_date, id`
+	err := r.db.SelectContext(ctx, &changes, query, until.Format("2006-01-02"))
+	return changes, err
+}
+
+// catStatsQuery aggregates the missions and targets of every cat. Missions are counted
+// for every cat that was on their team, in any role, but only cats still on the team
+// when a mission was completed are credited with completing it. The countries are those
+// of all targets of the cat's missions.
+const catStatsQuery = `SELECT c.id AS cat_id, c.name AS cat_name,
+		COALESCE(m.missions_assigned, 0) AS missions_assigned,
+		COALESCE(m.missions_completed, 0) AS missions_completed,
+		COALESCE(t.targets_completed, 0) AS targets_completed,
+		m.avg_completion_hours,
+		COALESCE(t.countries, '{}') AS countries
+	FROM cats c
+	LEFT JOIN (
+		SELECT tm.cat_id,
+			COUNT(DISTINCT ms.id) AS missions_assigned,
+			COUNT(DISTINCT ms.id) FILTER (WHERE ms.status = 'completed' AND tm.left_at IS NULL) AS missions_completed,
+			AVG(EXTRACT(EPOCH FROM ms.ended_at - tm.joined_at) / 3600)
+				FILTER (WHERE ms.status = 'completed' AND tm.left_at IS NULL) AS avg_completion_hours
+		FROM mission_team_members tm JOIN missions ms ON ms.id = tm.mission_id
+		GROUP BY tm.cat_id
+	) m ON m.cat_id = c.id
+	LEFT JOIN (
+		SELECT tm.cat_id,
+			COUNT(*) FILTER (WHERE tg.completed) AS targets_completed,
+			array_agg(DISTINCT tg.country ORDER BY tg.country) AS countries
+		FROM targets tg JOIN (SELECT DISTINCT mission_id, cat_id FROM mission_team_members) tm ON tm.mission_id = tg.mission_id
+		GROUP BY tm.cat_id
+	) t ON t.cat_id = c.id`
+
+// catStatsSortColumns maps leaderboard sort keys to their ORDER BY clauses.
+var catStatsSortColumns = map[string]string{
+	domain.CatStatsSortMissionsCompleted: "missions_completed DESC, targets_completed DESC",
+	domain.CatStatsSortTargetsCompleted:  "targets_completed DESC, missions_completed DESC",
+	domain.CatStatsSortMissionsAssigned:  "missions_assigned DESC, missions_completed DESC",
+	domain.CatStatsSortAvgCompletion:     "avg_completion_hours ASC NULLS LAST, missions_completed DESC",
+}
+
+// catStatsRow is a row of catStatsQuery.
+type catStatsRow struct {
+	domain.CatStats
+	Countries pq.StringArray `db:"countries"`
+}
+
+func (row catStatsRow) toDomain() domain.CatStats {
+	stats := row.CatStats
+	stats.Countries = row.Countries
+	return stats
+}
+
+// GetCatStats aggregates the performance statistics of a single cat.
+func (r *CatRepository) GetCatStats(ctx context.Context, catID int) (*domain.CatStats, error) {
+	var row catStatsRow
+	if err := r.db.GetContext(ctx, &row, catStatsQuery+` WHERE c.id = $1`, catID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("cat not found")
+		}
+		return nil, err
+	}
+	stats := row.toDomain()
+	return &stats, nil
+}
+
+// ListCatStats aggregates the performance statistics of all active cats, best first.
+func (r *CatRepository) ListCatStats(ctx context.Context, sortBy string, limit int) ([]domain.CatStats, error) {
+	orderBy, ok := catStatsSortColumns[sortBy]
+	if !ok {
+		return nil, fmt.Errorf("invalid sort key: %s", sortBy)
+	}
+
+	var rows []catStatsRow
+	query := catStatsQuery + ` WHERE c.archived_at IS NULL ORDER BY ` + orderBy + `, c.id LIMIT $1`
+	if err := r.db.SelectContext(ctx, &rows, query, pageLimit(limit)); err != nil {
+		return nil, err
+	}
+
+	stats := make([]domain.CatStats, len(rows))
+	for i, row := range rows {
+		stats[i] = row.toDomain()
+	}
+	return stats, nil
+}
+
+// insertSalaryChange appends a salary history entry. A zero EffectiveDate means today.
+func insertSalaryChange(ctx context.Context, tx *Tx, change *domain.SalaryChange) error {
+	var effectiveDate *string
+	if !change.EffectiveDate.IsZero() {
+		date := change.EffectiveDate.Format("2006-01-02")
+		effectiveDate = &date
+	}
+
+	query := `INSERT INTO cat_salary_changes (cat_id, old_salary, new_salary, effective_date, reason)
+			  VALUES ($1, $2, $3, COALESCE($4::date, CURRENT_DATE), $5)
+			  RETURNING id, effective_date, created_at`
+	return tx.QueryRowxContext(ctx, query, change.CatID, change.OldSalary, change.NewSalary, effectiveDate, change.Reason).
+		Scan(&change.ID, &change.EffectiveDate, &change.CreatedAt)
+}
+
+// UpdateCatStatus moves a cat to cat.Status, provided its status is still from.
+// Checking the previous status keeps concurrent changes from skipping the transition rules.
+func (r *CatRepository) UpdateCatStatus(ctx context.Context, cat *domain.Cat, from domain.CatStatus) error {
+	query := `UPDATE cats SET status = $1, updated_at = now() WHERE id = $2 AND status = $3 RETURNING updated_at`
+	err := r.db.QueryRowxContext(ctx, query, cat.Status, cat.ID, from).Scan(&cat.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("cat status has changed, please retry")
+	}
 	return err
 }
+
+// RetireCat archives a cat with the given reason and records the retirement within a
+// transaction. Cats on the team of a mission that hasn't ended yet are left untouched.
+func (r *CatRepository) RetireCat(ctx context.Context, id int, reason string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE cats SET status = $3, archived_at = now(), archive_reason = $2, updated_at = now()
+			  WHERE id = $1 AND archived_at IS NULL
+			  AND NOT EXISTS (SELECT 1 FROM mission_team_members t JOIN missions m ON m.id = t.mission_id
+			                  WHERE t.cat_id = $1 AND t.left_at IS NULL AND m.status NOT IN ` + endedMissionStatuses + `)`
+	result, err := tx.ExecContext(ctx, query, id, reason, domain.CatStatusRetired)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("cat is on an active mission, already retired or does not exist")
+	}
+
+	retirementQuery := `INSERT INTO cat_retirements (cat_id, retired_at, reason) VALUES ($1, now(), $2)`
+	if _, err := tx.ExecContext(ctx, retirementQuery, id, reason); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// RestoreCat brings a retired cat back into service and ends its retirement within a
+// transaction.
+func (r *CatRepository) RestoreCat(ctx context.Context, cat *domain.Cat) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE cats SET status = $2, archived_at = NULL, archive_reason = '', updated_at = now()
+			  WHERE id = $1 AND archived_at IS NOT NULL
+			  RETURNING status, updated_at`
+	err = tx.QueryRowxContext(ctx, query, cat.ID, domain.CatStatusAvailable).Scan(&cat.Status, &cat.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("cat is not retired")
+	}
+	if err != nil {
+		return err
+	}
+
+	retirementQuery := `UPDATE cat_retirements SET restored_at = now() WHERE cat_id = $1 AND restored_at IS NULL`
+	if _, err := tx.ExecContext(ctx, retirementQuery, cat.ID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	cat.ArchivedAt = nil
+	cat.ArchiveReason = ""
+	return nil
+}
+
+// ListRetirementsUntil retrieves the retirements of all cats that began on or before the
+// given date, ordered by cat and time.
+func (r *CatRepository) ListRetirementsUntil(ctx context.Context, until time.Time) ([]domain.CatRetirement, error) {
+	retirements := []domain.CatRetirement{}
+	query := `SELECT id, cat_id, retired_at, restored_at, reason
+			  FROM cat_retirements WHERE retired_at::date <= $1::date ORDER BY cat_id, retired_at, id`
+	err := r.db.SelectContext(ctx, &retirements, query, until.Format("2006-01-02"))
+	return retirements, err
+}
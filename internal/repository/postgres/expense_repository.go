@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"spy_cats_agency/internal/domain"
+	"spy_cats_agency/internal/repository"
+)
+
+// ExpenseRepository implements the repository.ExpenseRepository interface.
+type ExpenseRepository struct {
+	db *DB
+}
+
+// NewExpenseRepository creates a new expense repository.
+func NewExpenseRepository(db *DB) repository.ExpenseRepository {
+	return &ExpenseRepository{db: db}
+}
+
+// CreateExpense records an expense of a mission within a transaction. The mission row is
+// locked while the budget is checked, so that concurrent expenses can't overspend it
+// together. An expense exceeding the remaining budget is only recorded with
+// allowOverBudget, and is then flagged as over budget.
+func (r *ExpenseRepository) CreateExpense(ctx context.Context, expense *domain.Expense, allowOverBudget bool) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var budget *float64
+	if err := tx.GetContext(ctx, &budget, `SELECT budget FROM missions WHERE id = $1 FOR UPDATE`, expense.MissionID); err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("mission not found")
+		}
+		return err
+	}
+
+	var spent float64
+	spentQuery := `SELECT COALESCE(SUM(amount), 0) FROM mission_expenses WHERE mission_id = $1`
+	if err := tx.GetContext(ctx, &spent, spentQuery, expense.MissionID); err != nil {
+		return err
+	}
+
+	// Compare in cents, as the amounts are decimals in the database
+	expense.OverBudget = budget != nil && math.Round((spent+expense.Amount)*100) > math.Round(*budget*100)
+	if expense.OverBudget && !allowOverBudget {
+		return fmt.Errorf("expense of %.2f exceeds the remaining budget of %.2f", expense.Amount, *budget-spent)
+	}
+
+	query := `INSERT INTO mission_expenses (mission_id, amount, category, spent_on, description, over_budget)
+			  VALUES ($1, $2, $3, $4::date, $5, $6) RETURNING id, created_at`
+	err = tx.QueryRowxContext(ctx, query, expense.MissionID, expense.Amount, expense.Category, expense.SpentOn.Format("2006-01-02"), expense.Description, expense.OverBudget).
+		Scan(&expense.ID, &expense.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ListExpenses retrieves the expense ledger of a mission in the order the money was spent.
+func (r *ExpenseRepository) ListExpenses(ctx context.Context, missionID int) ([]domain.Expense, error) {
+	expenses := []domain.Expense{}
+	query := `SELECT id, mission_id, amount, category, spent_on, description, over_budget, created_at
+			  FROM mission_expenses WHERE mission_id = $1 ORDER BY spent_on, id`
+	err := r.db.SelectContext(ctx, &expenses, query, missionID)
+	return expenses, err
+}
+
+// SetBudget replaces the budget of a mission. A nil budget removes the limit.
+func (r *ExpenseRepository) SetBudget(ctx context.Context, missionID int, budget *float64) error {
+	query := `UPDATE missions SET budget = $1, updated_at = now() WHERE id = $2`
+	result, err := r.db.ExecContext(ctx, query, budget, missionID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err == nil && rowsAffected == 0 {
+		return fmt.Errorf("mission not found")
+	}
+	return err
+}
@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"spy_cats_agency/internal/domain"
+	"spy_cats_agency/internal/repository"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// LeaveRepository implements the repository.LeaveRepository interface.
+type LeaveRepository struct {
+	db *DB
+}
+
+// NewLeaveRepository creates a new leave repository.
+func NewLeaveRepository(db *DB) repository.LeaveRepository {
+	return &LeaveRepository{db: db}
+}
+
+// CreateLeave schedules a leave period for a cat within a transaction. The cat row is
+// locked while its status is checked, so a retired cat gets no leave and a cat can't be
+// put on a mission concurrently with a leave that starts by today. Leaves overlapping
+// another leave of the cat are rejected by the database.
+func (r *LeaveRepository) CreateLeave(ctx context.Context, leave *domain.Leave, today time.Time) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var status domain.CatStatus
+	if err := tx.GetContext(ctx, &status, `SELECT status FROM cats WHERE id = $1 FOR UPDATE`, leave.CatID); err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("cat not found")
+		}
+		return err
+	}
+	if status == domain.CatStatusRetired {
+		return fmt.Errorf("cannot schedule leave for a retired cat")
+	}
+	if !leave.StartDate.After(today) && status == domain.CatStatusOnMission {
+		return fmt.Errorf("cat is on a mission and can't go on leave before it ends")
+	}
+
+	query := `INSERT INTO cat_leaves (cat_id, kind, start_date, end_date, reason)
+			  VALUES ($1, $2, $3::date, $4::date, $5) RETURNING id, created_at`
+	err = tx.QueryRowxContext(ctx, query, leave.CatID, leave.Kind, leave.StartDate.Format("2006-01-02"), leave.EndDate.Format("2006-01-02"), leave.Reason).
+		Scan(&leave.ID, &leave.CreatedAt)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23P01" && pqErr.Constraint == "cat_leaves_period_excl" {
+			return fmt.Errorf("leave overlaps another leave of the cat")
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ListLeaves retrieves the leave periods of a cat that overlap the inclusive range [from, to].
+func (r *LeaveRepository) ListLeaves(ctx context.Context, catID int, from, to time.Time) ([]domain.Leave, error) {
+	leaves := []domain.Leave{}
+	query := `SELECT id, cat_id, kind, start_date, end_date, reason, created_at FROM cat_leaves
+			  WHERE cat_id = $1 AND start_date <= $3::date AND end_date >= $2::date
+			  ORDER BY start_date, id`
+	err := r.db.SelectContext(ctx, &leaves, query, catID, from.Format("2006-01-02"), to.Format("2006-01-02"))
+	return leaves, err
+}
+
+// SyncLeaveStatuses puts available cats whose leave covers today on leave, and makes cats
+// on leave available again once no leave covers today. Only the given cat is synced
+// unless catID is nil. It returns how many cats went on and came back from leave.
+func (r *LeaveRepository) SyncLeaveStatuses(ctx context.Context, today time.Time, catID *int) (started, ended int, err error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer tx.Rollback()
+
+	scope := `($4::bigint IS NULL OR cats.id = $4)`
+	onLeave := `EXISTS (SELECT 1 FROM cat_leaves l WHERE l.cat_id = cats.id AND $1::date BETWEEN l.start_date AND l.end_date)`
+
+	startQuery := `UPDATE cats SET status = $2, updated_at = now()
+				   WHERE status = $3 AND archived_at IS NULL AND ` + scope + ` AND ` + onLeave
+	result, err := tx.ExecContext(ctx, startQuery, today.Format("2006-01-02"), domain.CatStatusOnLeave, domain.CatStatusAvailable, catID)
+	if err != nil {
+		return 0, 0, err
+	}
+	startedRows, err := result.RowsAffected()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	endQuery := `UPDATE cats SET status = $2, updated_at = now()
+				 WHERE status = $3 AND ` + scope + ` AND NOT ` + onLeave
+	result, err = tx.ExecContext(ctx, endQuery, today.Format("2006-01-02"), domain.CatStatusAvailable, domain.CatStatusOnLeave, catID)
+	if err != nil {
+		return 0, 0, err
+	}
+	endedRows, err := result.RowsAffected()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return int(startedRows), int(endedRows), tx.Commit()
+}
@@ -0,0 +1,36 @@
+package postgres
+
+import (
+	"context"
+	"spy_cats_agency/internal/domain"
+	"spy_cats_agency/internal/repository"
+)
+
+// MissionEventRepository implements the repository.MissionEventRepository interface.
+type MissionEventRepository struct {
+	db *DB
+}
+
+// NewMissionEventRepository creates a new mission event repository.
+func NewMissionEventRepository(db *DB) repository.MissionEventRepository {
+	return &MissionEventRepository{db: db}
+}
+
+// CreateEvent adds an event to the timeline of a mission.
+func (r *MissionEventRepository) CreateEvent(ctx context.Context, event *domain.MissionEvent) error {
+	// The payload is sent as text, as pq would encode a byte slice as bytea
+	query := `INSERT INTO mission_events (mission_id, kind, actor, payload)
+			  VALUES ($1, $2, $3, $4::jsonb) RETURNING id, created_at`
+	return r.db.QueryRowxContext(ctx, query, event.MissionID, event.Kind, event.Actor, string(event.Payload)).
+		Scan(&event.ID, &event.CreatedAt)
+}
+
+// ListEvents retrieves the timeline of a mission, oldest event first. Events of the same
+// transaction share their time and keep the order they were recorded in.
+func (r *MissionEventRepository) ListEvents(ctx context.Context, missionID int) ([]domain.MissionEvent, error) {
+	events := []domain.MissionEvent{}
+	query := `SELECT id, mission_id, kind, actor, payload, created_at
+			  FROM mission_events WHERE mission_id = $1 ORDER BY created_at, id`
+	err := r.db.SelectContext(ctx, &events, query, missionID)
+	return events, err
+}
@@ -2,11 +2,48 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"sort"
 	"spy_cats_agency/internal/domain"
 	"spy_cats_agency/internal/repository"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
 )
 
+// missionColumns lists the columns selected into domain.Mission.
+const missionColumns = `id, codename, code, cat_id, status, (status = 'completed') AS completed, priority, deadline, budget, overdue_at,
+	cloned_from_id, assigned_at, started_at, ended_at, created_at, updated_at`
+
+// endedMissionStatuses is the SQL list of the statuses of missions that have ended.
+const endedMissionStatuses = `('completed', 'aborted', 'failed')`
+
+// missionCodeError translates a violation of the unique codename or code of a mission
+// into repository.ErrMissionCodeTaken, so that the caller can retry with new ones.
+func missionCodeError(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" &&
+		(pqErr.Constraint == "missions_codename_key" || pqErr.Constraint == "missions_code_key") {
+		return repository.ErrMissionCodeTaken
+	}
+	return err
+}
+
+// missionStatusTimes renders the assignments that keep the start and end times of a
+// mission in line with the status bound to param. The start time is set when work
+// begins and cleared if the mission goes back before that, the end time is set when
+// the mission ends.
+func missionStatusTimes(param string) string {
+	return fmt.Sprintf(`started_at = CASE WHEN %[1]s::varchar = 'in_progress' THEN COALESCE(started_at, now())
+			                       WHEN %[1]s::varchar IN ('draft', 'assigned') THEN NULL
+			                       ELSE started_at END,
+			  ended_at = CASE WHEN %[1]s::varchar IN `+endedMissionStatuses+` THEN COALESCE(ended_at, now()) END`, param)
+}
+
 // MissionRepository implements the repository.MissionRepository interface.
 type MissionRepository struct {
 	db *DB
@@ -18,6 +55,8 @@ func NewMissionRepository(db *DB) repository.MissionRepository {
 }
 
 // CreateMission creates a new mission and its associated targets within a transaction.
+// A cat assigned right away is put on the mission as the lead of its team in the same
+// transaction.
 func (r *MissionRepository) CreateMission(ctx context.Context, mission *domain.Mission) error {
 	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
@@ -26,9 +65,22 @@ func (r *MissionRepository) CreateMission(ctx context.Context, mission *domain.M
 	defer tx.Rollback()
 
 	// Create the mission
-	missionQuery := `INSERT INTO missions (cat_id, completed) VALUES ($1, $2) RETURNING id, created_at, updated_at`
-	err = tx.QueryRowxContext(ctx, missionQuery, mission.CatID, mission.Completed).Scan(&mission.ID, &mission.CreatedAt, &mission.UpdatedAt)
+	missionQuery := `INSERT INTO missions (codename, code, cat_id, status, priority, deadline, budget, assigned_at)
+					 VALUES ($1, $2, $3, $4, $5, $6, $7, CASE WHEN $3::bigint IS NOT NULL THEN now() END)
+					 RETURNING id, assigned_at, created_at, updated_at`
+	err = tx.QueryRowxContext(ctx, missionQuery, mission.Codename, mission.Code, mission.CatID, mission.Status, mission.Priority, mission.Deadline, mission.Budget).
+		Scan(&mission.ID, &mission.AssignedAt, &mission.CreatedAt, &mission.UpdatedAt)
 	if err != nil {
+		return missionCodeError(err)
+	}
+
+	if mission.CatID != nil {
+		if err := joinTeam(ctx, tx, mission.ID, *mission.CatID, domain.TeamRoleLead); err != nil {
+			return err
+		}
+	}
+
+	if err := insertRequiredSkills(ctx, tx, mission.ID, mission.RequiredSkills); err != nil {
 		return err
 	}
 
@@ -49,10 +101,50 @@ func (r *MissionRepository) CreateMission(ctx context.Context, mission *domain.M
 	return tx.Commit()
 }
 
+// CloneMission copies a mission into a new unassigned draft with the given codename and
+// code within a transaction and returns the new mission's ID. The priority, budget,
+// required skills and targets are copied, with target completion cleared and notes kept
+// only if keepNotes is set. The deadline is not copied, as it usually has passed, and
+// neither are the expenses.
+func (r *MissionRepository) CloneMission(ctx context.Context, sourceID int, keepNotes bool, codename, code string) (int, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var id int
+	missionQuery := `INSERT INTO missions (codename, code, status, priority, budget, cloned_from_id)
+					 SELECT $2, $3, $4, priority, budget, id FROM missions WHERE id = $1
+					 RETURNING id`
+	if err := tx.QueryRowxContext(ctx, missionQuery, sourceID, codename, code, domain.MissionStatusDraft).Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return 0, fmt.Errorf("mission not found")
+		}
+		return 0, missionCodeError(err)
+	}
+
+	skillQuery := `INSERT INTO mission_required_skills (mission_id, skill_id, min_proficiency)
+				   SELECT $2, skill_id, min_proficiency FROM mission_required_skills WHERE mission_id = $1`
+	if _, err := tx.ExecContext(ctx, skillQuery, sourceID, id); err != nil {
+		return 0, err
+	}
+
+	// The ORDER BY hands out the new target IDs in the order of the source targets
+	targetQuery := `INSERT INTO targets (mission_id, name, country, notes)
+					SELECT $2, name, country, CASE WHEN $3 THEN notes ELSE '' END
+					FROM targets WHERE mission_id = $1 ORDER BY created_at, id`
+	if _, err := tx.ExecContext(ctx, targetQuery, sourceID, id, keepNotes); err != nil {
+		return 0, err
+	}
+
+	return id, tx.Commit()
+}
+
 // GetMissionByID retrieves a mission and its targets.
 func (r *MissionRepository) GetMissionByID(ctx context.Context, id int) (*domain.Mission, error) {
 	var mission domain.Mission
-	query := `SELECT id, cat_id, completed, created_at, updated_at FROM missions WHERE id = $1`
+	query := `SELECT ` + missionColumns + ` FROM missions WHERE id = $1`
 	if err := r.db.GetContext(ctx, &mission, query, id); err != nil {
 		return nil, err
 	}
@@ -67,23 +159,238 @@ func (r *MissionRepository) GetMissionByID(ctx context.Context, id int) (*domain
 	}
 	mission.Targets = targets
 
+	requirements := []domain.SkillRequirement{}
+	requirementQuery := `SELECT r.skill_id, s.name, r.min_proficiency
+			 FROM mission_required_skills r JOIN skills s ON s.id = r.skill_id
+			 WHERE r.mission_id = $1 ORDER BY s.name`
+	if err := r.db.SelectContext(ctx, &requirements, requirementQuery, id); err != nil {
+		return nil, err
+	}
+	mission.RequiredSkills = requirements
+
+	team := []domain.TeamMember{}
+	teamQuery := `SELECT t.mission_id, t.cat_id, c.name AS cat_name, t.role, t.joined_at
+			 FROM mission_team_members t JOIN cats c ON c.id = t.cat_id
+			 WHERE t.mission_id = $1 AND t.left_at IS NULL ORDER BY t.role = 'lead' DESC, t.joined_at, t.cat_id`
+	if err := r.db.SelectContext(ctx, &team, teamQuery, id); err != nil {
+		return nil, err
+	}
+	mission.Team = team
+
 	return &mission, nil
 }
 
-// ListMissions retrieves all missions.
-func (r *MissionRepository) ListMissions(ctx context.Context) ([]domain.Mission, error) {
-	var missions []domain.Mission
-	query := `SELECT id, cat_id, completed, created_at, updated_at FROM missions ORDER BY created_at DESC`
-	if err := r.db.SelectContext(ctx, &missions, query); err != nil {
+// GetMissionByCode retrieves a mission and its targets by its reference code.
+func (r *MissionRepository) GetMissionByCode(ctx context.Context, code string) (*domain.Mission, error) {
+	var id int
+	if err := r.db.GetContext(ctx, &id, `SELECT id FROM missions WHERE code = $1`, code); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("mission not found")
+		}
+		return nil, err
+	}
+	return r.GetMissionByID(ctx, id)
+}
+
+// missionSortColumns maps the sort keys of domain.MissionFilter to their columns.
+// Missions without a deadline sort as if it were infinitely far away.
+var missionSortColumns = map[string]struct {
+	sortColumn
+	value func(mission domain.Mission) string
+}{
+	domain.MissionSortCreatedAt: {
+		sortColumn{expr: "created_at", cast: "timestamptz", defaultDesc: true},
+		func(mission domain.Mission) string { return mission.CreatedAt.Format(time.RFC3339Nano) },
+	},
+	domain.MissionSortPriority: {
+		sortColumn{expr: "priority", cast: "int"},
+		func(mission domain.Mission) string { return strconv.Itoa(mission.Priority) },
+	},
+	domain.MissionSortDeadline: {
+		sortColumn{expr: "COALESCE(deadline, 'infinity')", cast: "timestamptz"},
+		func(mission domain.Mission) string {
+			if mission.Deadline == nil {
+				return "infinity"
+			}
+			return mission.Deadline.Format(time.RFC3339Nano)
+		},
+	},
+}
+
+// ListMissions retrieves a page of missions matching the filter. With IncludeTargets,
+// the targets of all missions on the page are loaded in a single query.
+func (r *MissionRepository) ListMissions(ctx context.Context, filter domain.MissionFilter) (*domain.MissionPage, error) {
+	sortBy := filter.SortBy
+	if sortBy == "" {
+		sortBy = domain.MissionSortCreatedAt
+	}
+	sort, ok := missionSortColumns[sortBy]
+	if !ok {
+		return nil, fmt.Errorf("invalid sort key: %s", sortBy)
+	}
+	desc, err := sortDesc(sort.sortColumn, filter.SortOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	var b whereBuilder
+	if filter.Completed != nil {
+		if *filter.Completed {
+			b.add("status = 'completed'")
+		} else {
+			b.add("status <> 'completed'")
+		}
+	}
+	if filter.CatID != nil {
+		b.add("cat_id = " + b.arg(*filter.CatID))
+	}
+	if filter.Country != "" {
+		b.add("EXISTS (SELECT 1 FROM targets t WHERE t.mission_id = missions.id AND lower(t.country) = lower(" + b.arg(filter.Country) + "))")
+	}
+	if filter.CreatedFrom != nil {
+		b.add("created_at >= " + b.arg(*filter.CreatedFrom))
+	}
+	if filter.CreatedBefore != nil {
+		b.add("created_at < " + b.arg(*filter.CreatedBefore))
+	}
+
+	orderBy, err := applyKeyset(&b, sort.sortColumn, sortBy, desc, filter.Cursor, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch one extra row to find out whether another page follows.
+	limit := pageLimit(filter.Limit)
+	query := `SELECT ` + missionColumns + ` FROM missions` + b.clause() + orderBy + fmt.Sprintf(" LIMIT %d", limit+1)
+
+	missions := []domain.Mission{}
+	if err := r.db.SelectContext(ctx, &missions, query, b.args...); err != nil {
+		return nil, err
+	}
+
+	page := &domain.MissionPage{Missions: missions}
+	if len(missions) > limit {
+		page.Missions = missions[:limit]
+		last := page.Missions[limit-1]
+		page.NextCursor = encodeCursor(cursor{SortBy: sortBy, Desc: desc, Value: sort.value(last), ID: last.ID})
+	}
+
+	if filter.IncludeTargets {
+		if err := r.loadTargets(ctx, page.Missions); err != nil {
+			return nil, err
+		}
+	}
+	return page, nil
+}
+
+// loadTargets fills in the targets of the given missions with a single query.
+func (r *MissionRepository) loadTargets(ctx context.Context, missions []domain.Mission) error {
+	if len(missions) == 0 {
+		return nil
+	}
+
+	ids := make([]int, len(missions))
+	byID := make(map[int]*domain.Mission, len(missions))
+	for i := range missions {
+		ids[i] = missions[i].ID
+		byID[missions[i].ID] = &missions[i]
+		missions[i].Targets = []domain.Target{}
+	}
+
+	var targets []domain.Target
+	query := `SELECT id, mission_id, name, country, notes, completed, created_at, updated_at
+			  FROM targets WHERE mission_id = ANY($1) ORDER BY mission_id, created_at`
+	if err := r.db.SelectContext(ctx, &targets, query, pq.Array(ids)); err != nil {
+		return err
+	}
+	for _, t := range targets {
+		m := byID[t.MissionID]
+		m.Targets = append(m.Targets, t)
+	}
+	return nil
+}
+
+// FlagOverdueMissions marks the missions that are still active past their deadline as
+// overdue and returns the missions that weren't flagged before.
+func (r *MissionRepository) FlagOverdueMissions(ctx context.Context, now time.Time) ([]domain.Mission, error) {
+	missions := []domain.Mission{}
+	query := `UPDATE missions SET overdue_at = $1, updated_at = now()
+			  WHERE deadline < $1 AND overdue_at IS NULL AND status NOT IN ` + endedMissionStatuses + `
+			  RETURNING ` + missionColumns
+	if err := r.db.SelectContext(ctx, &missions, query, now); err != nil {
 		return nil, err
 	}
 	return missions, nil
 }
 
-// UpdateMission updates a mission's state.
+// ListOverdueMissions retrieves the missions that are still active past their deadline,
+// most overdue first.
+func (r *MissionRepository) ListOverdueMissions(ctx context.Context, now time.Time) ([]domain.Mission, error) {
+	missions := []domain.Mission{}
+	query := `SELECT ` + missionColumns + ` FROM missions
+			  WHERE deadline < $1 AND status NOT IN ` + endedMissionStatuses + `
+			  ORDER BY deadline, priority, id`
+	if err := r.db.SelectContext(ctx, &missions, query, now); err != nil {
+		return nil, err
+	}
+	return missions, nil
+}
+
+// ListMissionsByCat retrieves the missions a cat leads or is on the team of, or ever was,
+// latest first.
+func (r *MissionRepository) ListMissionsByCat(ctx context.Context, catID int) ([]domain.Mission, error) {
+	missions := []domain.Mission{}
+	query := `SELECT ` + missionColumns + ` FROM missions
+			  WHERE cat_id = $1 OR id IN (SELECT mission_id FROM mission_team_members WHERE cat_id = $1)
+			  ORDER BY assigned_at DESC NULLS LAST, id DESC`
+	if err := r.db.SelectContext(ctx, &missions, query, catID); err != nil {
+		return nil, err
+	}
+	return missions, nil
+}
+
+// UpdateMission updates a mission's state. The assignment time follows changes of the
+// assigned cat, and the start and end times follow the status.
 func (r *MissionRepository) UpdateMission(ctx context.Context, mission *domain.Mission) error {
-	query := `UPDATE missions SET cat_id = $1, completed = $2, updated_at = now() WHERE id = $3 RETURNING updated_at`
-	return r.db.QueryRowxContext(ctx, query, mission.CatID, mission.Completed, mission.ID).Scan(&mission.UpdatedAt)
+	query := `UPDATE missions SET cat_id = $1, status = $2,
+			  assigned_at = CASE WHEN $1::bigint IS NULL THEN NULL
+			                     WHEN cat_id IS DISTINCT FROM $1::bigint THEN now()
+			                     ELSE assigned_at END,
+			  ` + missionStatusTimes("$2") + `, updated_at = now()
+			  WHERE id = $3 RETURNING (status = 'completed'), assigned_at, started_at, ended_at, updated_at`
+	return r.db.QueryRowxContext(ctx, query, mission.CatID, mission.Status, mission.ID).
+		Scan(&mission.Completed, &mission.AssignedAt, &mission.StartedAt, &mission.EndedAt, &mission.UpdatedAt)
+}
+
+// UpdateMissionStatus moves a mission to mission.Status, provided it is still in status
+// from. This guards against concurrent transitions. The start and end times follow the
+// status, and the whole team is released within the same transaction once the mission ends.
+func (r *MissionRepository) UpdateMissionStatus(ctx context.Context, mission *domain.Mission, from domain.MissionStatus) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE missions SET status = $2, ` + missionStatusTimes("$2") + `, updated_at = now()
+			  WHERE id = $1 AND status = $3
+			  RETURNING (status = 'completed'), started_at, ended_at, updated_at`
+	err = tx.QueryRowxContext(ctx, query, mission.ID, mission.Status, from).
+		Scan(&mission.Completed, &mission.StartedAt, &mission.EndedAt, &mission.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("mission status has changed, please retry")
+	}
+	if err != nil {
+		return err
+	}
+
+	if mission.Status.IsTerminal() {
+		if err := releaseTeam(ctx, tx, mission.ID); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
 }
 
 // DeleteMission deletes a mission.
@@ -100,24 +407,187 @@ func (r *MissionRepository) DeleteMission(ctx context.Context, id int) error {
 	return err
 }
 
-// AssignCatToMission assigns a cat to a mission and updates the cat's status.
-func (r *MissionRepository) AssignCatToMission(ctx context.Context, missionID, catID int) error {
+// ReassignCat moves a mission to the cat and status set on it within a transaction,
+// provided the mission still has cat from and status fromStatus. The outgoing cat is
+// made available again and leaves the team, and the incoming cat is put on the mission
+// as the team's lead, or promoted to it if it is already on the team. A mission left
+// without a cat releases its whole team. Cats that leave stay on record as having been
+// on the team.
+func (r *MissionRepository) ReassignCat(ctx context.Context, mission *domain.Mission, from *int, fromStatus domain.MissionStatus) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	missionQuery := `UPDATE missions SET cat_id = $2, status = $3,
+					 assigned_at = CASE WHEN $2::bigint IS NOT NULL THEN now() END,
+					 ` + missionStatusTimes("$3") + `, updated_at = now()
+					 WHERE id = $1 AND cat_id IS NOT DISTINCT FROM $4::bigint AND status = $5
+					 RETURNING assigned_at, started_at, ended_at, updated_at`
+	err = tx.QueryRowxContext(ctx, missionQuery, mission.ID, mission.CatID, mission.Status, from, fromStatus).
+		Scan(&mission.AssignedAt, &mission.StartedAt, &mission.EndedAt, &mission.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("mission has changed, please retry")
+	}
+	if err != nil {
+		return err
+	}
+
+	if mission.CatID == nil {
+		if err := releaseTeam(ctx, tx, mission.ID); err != nil {
+			return err
+		}
+		leaveQuery := `UPDATE mission_team_members SET left_at = now() WHERE mission_id = $1 AND left_at IS NULL`
+		if _, err := tx.ExecContext(ctx, leaveQuery, mission.ID); err != nil {
+			return err
+		}
+		return tx.Commit()
+	}
+
+	if from != nil {
+		if err := releaseCat(ctx, tx, *from); err != nil {
+			return err
+		}
+		if _, err := leaveTeam(ctx, tx, mission.ID, *from, true); err != nil {
+			return err
+		}
+	}
+
+	// A cat already on the team keeps its membership and only changes role
+	promoteQuery := `UPDATE mission_team_members SET role = $3 WHERE mission_id = $1 AND cat_id = $2 AND left_at IS NULL`
+	result, err := tx.ExecContext(ctx, promoteQuery, mission.ID, *mission.CatID, domain.TeamRoleLead)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		if err := joinTeam(ctx, tx, mission.ID, *mission.CatID, domain.TeamRoleLead); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// AddTeamMember puts a cat on the team of a mission within a transaction, provided the
+// mission still has a lead and hasn't ended. The mission row is locked so that the
+// mission can't end and release its team before the new member is on it.
+func (r *MissionRepository) AddTeamMember(ctx context.Context, member *domain.TeamMember) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := lockTeamMission(ctx, tx, member.MissionID); err != nil {
+		return err
+	}
+	if err := joinTeam(ctx, tx, member.MissionID, member.CatID, member.Role); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// RemoveTeamMember takes a cat other than the lead off the team of a mission that hasn't
+// ended and makes it available again within a transaction. The cat stays on record as
+// having been on the team.
+func (r *MissionRepository) RemoveTeamMember(ctx context.Context, missionID, catID int) error {
 	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback()
 
-	// Assign cat to mission
-	missionQuery := `UPDATE missions SET cat_id = $1, updated_at = now() WHERE id = $2`
-	_, err = tx.ExecContext(ctx, missionQuery, catID, missionID)
+	if err := lockTeamMission(ctx, tx, missionID); err != nil {
+		return err
+	}
+
+	left, err := leaveTeam(ctx, tx, missionID, catID, false)
 	if err != nil {
 		return err
 	}
+	if !left {
+		return fmt.Errorf("cat is not a support member of the mission")
+	}
+
+	if err := releaseCat(ctx, tx, catID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ListTeamHistory retrieves every cat that has been on the team of a mission, including
+// those that left, in the order they joined. A cat that rejoined appears once per stint.
+func (r *MissionRepository) ListTeamHistory(ctx context.Context, missionID int) ([]domain.TeamMember, error) {
+	team := []domain.TeamMember{}
+	query := `SELECT t.mission_id, t.cat_id, c.name AS cat_name, t.role, t.joined_at, t.left_at
+			  FROM mission_team_members t JOIN cats c ON c.id = t.cat_id
+			  WHERE t.mission_id = $1 ORDER BY t.joined_at, t.id`
+	err := r.db.SelectContext(ctx, &team, query, missionID)
+	return team, err
+}
+
+// lockTeamMission locks the row of a mission whose team can still change: one with a
+// lead that hasn't ended.
+func lockTeamMission(ctx context.Context, tx *Tx, missionID int) error {
+	var id int
+	query := `SELECT id FROM missions WHERE id = $1 AND cat_id IS NOT NULL AND status NOT IN ` + endedMissionStatuses + ` FOR UPDATE`
+	err := tx.GetContext(ctx, &id, query, missionID)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("mission has changed, please retry")
+	}
+	return err
+}
+
+// joinTeam adds a cat to a mission team in the given role and puts it on the mission.
+func joinTeam(ctx context.Context, tx *Tx, missionID, catID int, role domain.TeamRole) error {
+	query := `INSERT INTO mission_team_members (mission_id, cat_id, role) VALUES ($1, $2, $3)`
+	if _, err := tx.ExecContext(ctx, query, missionID, catID, role); err != nil {
+		return err
+	}
+	return claimCat(ctx, tx, catID)
+}
+
+// leaveTeam records that a cat left a mission team and reports whether it was on it. The
+// lead only leaves if withLead is set.
+func leaveTeam(ctx context.Context, tx *Tx, missionID, catID int, withLead bool) (bool, error) {
+	query := `UPDATE mission_team_members SET left_at = now()
+			  WHERE mission_id = $1 AND cat_id = $2 AND left_at IS NULL AND ($3 OR role <> $4)`
+	result, err := tx.ExecContext(ctx, query, missionID, catID, withLead, domain.TeamRoleLead)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	return rowsAffected > 0, err
+}
+
+// releaseTeam makes every cat on a mission team available again. The members stay on
+// record as the mission's team.
+func releaseTeam(ctx context.Context, tx *Tx, missionID int) error {
+	query := `UPDATE cats SET status = $2, updated_at = now()
+			  WHERE status = $3 AND id IN (SELECT cat_id FROM mission_team_members WHERE mission_id = $1 AND left_at IS NULL)`
+	_, err := tx.ExecContext(ctx, query, missionID, domain.CatStatusAvailable, domain.CatStatusOnMission)
+	return err
+}
 
-	// Update cat status
-	catQuery := `UPDATE cats SET status = 'on_mission', updated_at = now() WHERE id = $1 AND status = 'available'`
-	result, err := tx.ExecContext(ctx, catQuery, catID)
+// releaseCat makes a cat on a mission available again. A cat whose status changed during
+// the mission, e.g. to injured, keeps it.
+func releaseCat(ctx context.Context, tx *Tx, catID int) error {
+	query := `UPDATE cats SET status = $2, updated_at = now() WHERE id = $1 AND status = $3`
+	_, err := tx.ExecContext(ctx, query, catID, domain.CatStatusAvailable, domain.CatStatusOnMission)
+	return err
+}
+
+// claimCat puts an available cat on a mission.
+func claimCat(ctx context.Context, tx *Tx, catID int) error {
+	query := `UPDATE cats SET status = $2, updated_at = now() WHERE id = $1 AND status = $3`
+	result, err := tx.ExecContext(ctx, query, catID, domain.CatStatusOnMission, domain.CatStatusAvailable)
 	if err != nil {
 		return err
 	}
@@ -126,6 +596,254 @@ func (r *MissionRepository) AssignCatToMission(ctx context.Context, missionID, c
 	if err == nil && rowsAffected == 0 {
 		return fmt.Errorf("cat is not available")
 	}
+	return err
+}
+
+// AddDependency makes a mission depend on a prerequisite within a transaction, unless the
+// mission has already started or the prerequisite already depends on the mission,
+// directly or through other missions, which would create a cycle. Additions are
+// serialized so that two concurrent links can't close a cycle together, and the mission
+// row is locked so that it can't start before the link is in place.
+func (r *MissionRepository) AddDependency(ctx context.Context, dependency domain.MissionDependency) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `LOCK TABLE mission_dependencies IN SHARE ROW EXCLUSIVE MODE`); err != nil {
+		return err
+	}
+
+	var status domain.MissionStatus
+	if err := tx.GetContext(ctx, &status, `SELECT status FROM missions WHERE id = $1 FOR UPDATE`, dependency.MissionID); err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("mission not found")
+		}
+		return err
+	}
+	if status != domain.MissionStatusDraft && status != domain.MissionStatusAssigned {
+		return fmt.Errorf("cannot add a prerequisite to a mission that has already started")
+	}
+
+	var cycle bool
+	cycleQuery := `WITH RECURSIVE upstream AS (
+					   SELECT prerequisite_id FROM mission_dependencies WHERE mission_id = $1
+					   UNION
+					   SELECT d.prerequisite_id FROM mission_dependencies d JOIN upstream u ON d.mission_id = u.prerequisite_id
+				   )
+				   SELECT EXISTS (SELECT 1 FROM upstream WHERE prerequisite_id = $2)`
+	if err := tx.GetContext(ctx, &cycle, cycleQuery, dependency.PrerequisiteID, dependency.MissionID); err != nil {
+		return err
+	}
+	if cycle {
+		return fmt.Errorf("prerequisite depends on the mission, the dependency would create a cycle")
+	}
+
+	query := `INSERT INTO mission_dependencies (mission_id, prerequisite_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+	result, err := tx.ExecContext(ctx, query, dependency.MissionID, dependency.PrerequisiteID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("mission already depends on this prerequisite")
+	}
+
+	return tx.Commit()
+}
+
+// RemoveDependency removes a prerequisite from a mission.
+func (r *MissionRepository) RemoveDependency(ctx context.Context, dependency domain.MissionDependency) error {
+	query := `DELETE FROM mission_dependencies WHERE mission_id = $1 AND prerequisite_id = $2`
+	result, err := r.db.ExecContext(ctx, query, dependency.MissionID, dependency.PrerequisiteID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err == nil && rowsAffected == 0 {
+		return fmt.Errorf("dependency not found")
+	}
+	return err
+}
+
+// dependencyEdge is a dependency reached while walking the graph, with the distance
+// of its far end from the starting mission.
+type dependencyEdge struct {
+	domain.MissionDependency
+	Depth int `db:"depth"`
+}
+
+// GetDependencyGraph walks the dependencies of a mission in both directions: up to every
+// mission it transitively waits for, and down to every mission transitively waiting for it.
+func (r *MissionRepository) GetDependencyGraph(ctx context.Context, missionID int) (*domain.MissionDependencyGraph, error) {
+	var upstream, downstream []dependencyEdge
+	upstreamQuery := `WITH RECURSIVE up AS (
+						  SELECT mission_id, prerequisite_id, 1 AS depth FROM mission_dependencies WHERE mission_id = $1
+						  UNION
+						  SELECT d.mission_id, d.prerequisite_id, up.depth + 1
+						  FROM mission_dependencies d JOIN up ON d.mission_id = up.prerequisite_id
+					  )
+					  SELECT mission_id, prerequisite_id, MIN(depth) AS depth FROM up GROUP BY mission_id, prerequisite_id`
+	if err := r.db.SelectContext(ctx, &upstream, upstreamQuery, missionID); err != nil {
+		return nil, err
+	}
+	downstreamQuery := `WITH RECURSIVE down AS (
+							SELECT mission_id, prerequisite_id, 1 AS depth FROM mission_dependencies WHERE prerequisite_id = $1
+							UNION
+							SELECT d.mission_id, d.prerequisite_id, down.depth + 1
+							FROM mission_dependencies d JOIN down ON d.prerequisite_id = down.mission_id
+						)
+						SELECT mission_id, prerequisite_id, MIN(depth) AS depth FROM down GROUP BY mission_id, prerequisite_id`
+	if err := r.db.SelectContext(ctx, &downstream, downstreamQuery, missionID); err != nil {
+		return nil, err
+	}
+
+	// A mission reached along several paths is as deep as its shortest one
+	upDepth, downDepth := make(map[int]int), make(map[int]int)
+	graph := &domain.MissionDependencyGraph{MissionID: missionID, Edges: []domain.MissionDependency{}}
+	var ids []int
+	for _, e := range upstream {
+		if d, ok := upDepth[e.PrerequisiteID]; !ok || e.Depth < d {
+			upDepth[e.PrerequisiteID] = e.Depth
+		}
+		graph.Edges = append(graph.Edges, e.MissionDependency)
+		ids = append(ids, e.PrerequisiteID)
+	}
+	for _, e := range downstream {
+		if d, ok := downDepth[e.MissionID]; !ok || e.Depth < d {
+			downDepth[e.MissionID] = e.Depth
+		}
+		graph.Edges = append(graph.Edges, e.MissionDependency)
+		ids = append(ids, e.MissionID)
+	}
+
+	var nodes []domain.DependencyNode
+	nodeQuery := `SELECT id, codename, code, status FROM missions WHERE id = ANY($1)`
+	if err := r.db.SelectContext(ctx, &nodes, nodeQuery, pq.Array(ids)); err != nil {
+		return nil, err
+	}
+
+	graph.Upstream, graph.Downstream = []domain.DependencyNode{}, []domain.DependencyNode{}
+	for _, node := range nodes {
+		if depth, ok := upDepth[node.ID]; ok {
+			node.Depth = depth
+			graph.Upstream = append(graph.Upstream, node)
+		}
+		if depth, ok := downDepth[node.ID]; ok {
+			node.Depth = depth
+			graph.Downstream = append(graph.Downstream, node)
+		}
+	}
+	for _, list := range [][]domain.DependencyNode{graph.Upstream, graph.Downstream} {
+		sort.Slice(list, func(i, j int) bool {
+			if list[i].Depth != list[j].Depth {
+				return list[i].Depth < list[j].Depth
+			}
+			return list[i].ID < list[j].ID
+		})
+	}
+	sort.Slice(graph.Edges, func(i, j int) bool {
+		if graph.Edges[i].MissionID != graph.Edges[j].MissionID {
+			return graph.Edges[i].MissionID < graph.Edges[j].MissionID
+		}
+		return graph.Edges[i].PrerequisiteID < graph.Edges[j].PrerequisiteID
+	})
+
+	return graph, nil
+}
+
+// ListUnmetPrerequisites retrieves the IDs of the direct prerequisites of a mission that
+// haven't been completed.
+func (r *MissionRepository) ListUnmetPrerequisites(ctx context.Context, missionID int) ([]int, error) {
+	ids := []int{}
+	query := `SELECT d.prerequisite_id FROM mission_dependencies d JOIN missions m ON m.id = d.prerequisite_id
+			  WHERE d.mission_id = $1 AND m.status <> 'completed' ORDER BY d.prerequisite_id`
+	err := r.db.SelectContext(ctx, &ids, query, missionID)
+	return ids, err
+}
+
+// CountCompletedMissionsByCat counts, per cat, the missions completed within [from, to)
+// whose team the cat was on when they were completed, in any role.
+func (r *MissionRepository) CountCompletedMissionsByCat(ctx context.Context, from, to time.Time) (map[int]int, error) {
+	query := `SELECT t.cat_id, COUNT(DISTINCT m.id) AS count
+			  FROM missions m JOIN mission_team_members t ON t.mission_id = m.id AND t.left_at IS NULL
+			  WHERE m.status = 'completed' AND m.ended_at >= $1 AND m.ended_at < $2
+			  GROUP BY t.cat_id`
+	return r.countByCat(ctx, query, from, to)
+}
+
+// SetRequiredSkills replaces the skills a mission requires within a transaction.
+func (r *MissionRepository) SetRequiredSkills(ctx context.Context, missionID int, requirements []domain.SkillRequirement) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM mission_required_skills WHERE mission_id = $1`, missionID); err != nil {
+		return err
+	}
+	if err := insertRequiredSkills(ctx, tx, missionID, requirements); err != nil {
+		return err
+	}
 
 	return tx.Commit()
 }
+
+func insertRequiredSkills(ctx context.Context, tx *Tx, missionID int, requirements []domain.SkillRequirement) error {
+	query := `INSERT INTO mission_required_skills (mission_id, skill_id, min_proficiency) VALUES ($1, $2, $3)`
+	for _, req := range requirements {
+		if _, err := tx.ExecContext(ctx, query, missionID, req.SkillID, req.MinProficiency); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CountCompletedTargetsByCat counts, per cat, the completed targets of the missions whose
+// team it was on that are located in one of the given countries. Countries are compared
+// case-insensitively.
+func (r *MissionRepository) CountCompletedTargetsByCat(ctx context.Context, countries []string) (map[int]int, error) {
+	lowered := make([]string, len(countries))
+	for i, country := range countries {
+		lowered[i] = strings.ToLower(country)
+	}
+
+	query := `SELECT tm.cat_id, COUNT(*) AS count
+			  FROM targets t JOIN (SELECT DISTINCT mission_id, cat_id FROM mission_team_members) tm ON tm.mission_id = t.mission_id
+			  WHERE t.completed AND lower(t.country) = ANY($1)
+			  GROUP BY tm.cat_id`
+	return r.countByCat(ctx, query, pq.Array(lowered))
+}
+
+// CountRecentMissionsByCat counts, per cat, the missions whose team it is on or was on
+// since the given time, in any role.
+func (r *MissionRepository) CountRecentMissionsByCat(ctx context.Context, since time.Time) (map[int]int, error) {
+	query := `SELECT t.cat_id, COUNT(DISTINCT m.id) AS count
+			  FROM missions m JOIN mission_team_members t ON t.mission_id = m.id
+			  WHERE (t.left_at IS NULL AND (m.status NOT IN ` + endedMissionStatuses + ` OR m.ended_at >= $1))
+			     OR t.left_at >= $1
+			  GROUP BY t.cat_id`
+	return r.countByCat(ctx, query, since)
+}
+
+// countByCat runs a query returning cat_id and count columns and collects it into a map.
+func (r *MissionRepository) countByCat(ctx context.Context, query string, args ...interface{}) (map[int]int, error) {
+	var rows []struct {
+		CatID int `db:"cat_id"`
+		Count int `db:"count"`
+	}
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+
+	counts := make(map[int]int, len(rows))
+	for _, row := range rows {
+		counts[row.CatID] = row.Count
+	}
+	return counts, nil
+}
@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"spy_cats_agency/internal/domain"
+	"spy_cats_agency/internal/repository"
+)
+
+// MissionTemplateRepository implements the repository.MissionTemplateRepository interface.
+type MissionTemplateRepository struct {
+	db *DB
+}
+
+// NewMissionTemplateRepository creates a new mission template repository.
+func NewMissionTemplateRepository(db *DB) repository.MissionTemplateRepository {
+	return &MissionTemplateRepository{db: db}
+}
+
+// CreateTemplate stores a template and its targets within a transaction.
+func (r *MissionTemplateRepository) CreateTemplate(ctx context.Context, template *domain.MissionTemplate) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `INSERT INTO mission_templates (name, description) VALUES ($1, $2) RETURNING id, created_at, updated_at`
+	err = tx.QueryRowxContext(ctx, query, template.Name, template.Description).
+		Scan(&template.ID, &template.CreatedAt, &template.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	if err := insertTemplateTargets(ctx, tx, template); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetTemplateByID retrieves a template and its targets.
+func (r *MissionTemplateRepository) GetTemplateByID(ctx context.Context, id int) (*domain.MissionTemplate, error) {
+	var template domain.MissionTemplate
+	query := `SELECT id, name, description, created_at, updated_at FROM mission_templates WHERE id = $1`
+	if err := r.db.GetContext(ctx, &template, query, id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("mission template not found")
+		}
+		return nil, err
+	}
+
+	targets := []domain.TemplateTarget{}
+	targetQuery := `SELECT id, template_id, position, name, country, notes
+					FROM mission_template_targets WHERE template_id = $1 ORDER BY position`
+	if err := r.db.SelectContext(ctx, &targets, targetQuery, id); err != nil {
+		return nil, err
+	}
+	template.Targets = targets
+
+	return &template, nil
+}
+
+// ListTemplates retrieves all templates without their targets, by name.
+func (r *MissionTemplateRepository) ListTemplates(ctx context.Context) ([]domain.MissionTemplate, error) {
+	templates := []domain.MissionTemplate{}
+	query := `SELECT id, name, description, created_at, updated_at FROM mission_templates ORDER BY name`
+	err := r.db.SelectContext(ctx, &templates, query)
+	return templates, err
+}
+
+// UpdateTemplate replaces a template's name, description and targets within a transaction.
+func (r *MissionTemplateRepository) UpdateTemplate(ctx context.Context, template *domain.MissionTemplate) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE mission_templates SET name = $1, description = $2, updated_at = now()
+			  WHERE id = $3 RETURNING created_at, updated_at`
+	err = tx.QueryRowxContext(ctx, query, template.Name, template.Description, template.ID).
+		Scan(&template.CreatedAt, &template.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("mission template not found")
+		}
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM mission_template_targets WHERE template_id = $1`, template.ID); err != nil {
+		return err
+	}
+	if err := insertTemplateTargets(ctx, tx, template); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// DeleteTemplate deletes a template and its targets. Missions created from it are kept.
+func (r *MissionTemplateRepository) DeleteTemplate(ctx context.Context, id int) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM mission_templates WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err == nil && rowsAffected == 0 {
+		return fmt.Errorf("mission template not found")
+	}
+	return err
+}
+
+// insertTemplateTargets inserts the targets of a template, numbering them in order.
+func insertTemplateTargets(ctx context.Context, tx *Tx, template *domain.MissionTemplate) error {
+	query := `INSERT INTO mission_template_targets (template_id, position, name, country, notes)
+			  VALUES ($1, $2, $3, $4, $5) RETURNING id`
+	for i := range template.Targets {
+		t := &template.Targets[i]
+		t.TemplateID = template.ID
+		t.Position = i + 1
+		if err := tx.QueryRowxContext(ctx, query, t.TemplateID, t.Position, t.Name, t.Country, t.Notes).Scan(&t.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"spy_cats_agency/internal/domain"
+	"spy_cats_agency/internal/repository"
+
+	"github.com/lib/pq"
+)
+
+// PayrollRepository implements the repository.PayrollRepository interface.
+type PayrollRepository struct {
+	db *DB
+}
+
+// NewPayrollRepository creates a new payroll repository.
+func NewPayrollRepository(db *DB) repository.PayrollRepository {
+	return &PayrollRepository{db: db}
+}
+
+// CreatePayrollRun stores a payroll run and its entries within a transaction. Runs whose
+// period overlaps an existing run are rejected by the database.
+func (r *PayrollRepository) CreatePayrollRun(ctx context.Context, run *domain.PayrollRun) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	runQuery := `INSERT INTO payroll_runs (period_start, period_end, mission_bonus, total)
+				 VALUES ($1::date, $2::date, $3, $4) RETURNING id, created_at`
+	err = tx.QueryRowxContext(ctx, runQuery, run.PeriodStart.Format("2006-01-02"), run.PeriodEnd.Format("2006-01-02"), run.MissionBonus, run.Total).
+		Scan(&run.ID, &run.CreatedAt)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23P01" && pqErr.Constraint == "payroll_runs_period_excl" {
+			return fmt.Errorf("period overlaps an existing payroll run")
+		}
+		return err
+	}
+
+	for i := range run.Entries {
+		entry := &run.Entries[i]
+		entry.RunID = run.ID
+		entryQuery := `INSERT INTO payroll_entries (run_id, cat_id, cat_name, days_worked, base_pay, missions_completed, bonus, total)
+					   VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id`
+		err = tx.QueryRowxContext(ctx, entryQuery, entry.RunID, entry.CatID, entry.CatName, entry.DaysWorked, entry.BasePay, entry.MissionsCompleted, entry.Bonus, entry.Total).
+			Scan(&entry.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetPayrollRunByID retrieves a payroll run and its entries.
+func (r *PayrollRepository) GetPayrollRunByID(ctx context.Context, id int) (*domain.PayrollRun, error) {
+	var run domain.PayrollRun
+	query := `SELECT id, period_start, period_end, mission_bonus, total, created_at FROM payroll_runs WHERE id = $1`
+	if err := r.db.GetContext(ctx, &run, query, id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("payroll run not found")
+		}
+		return nil, err
+	}
+
+	entries := []domain.PayrollEntry{}
+	entryQuery := `SELECT id, run_id, cat_id, cat_name, days_worked, base_pay, missions_completed, bonus, total
+				   FROM payroll_entries WHERE run_id = $1 ORDER BY cat_name, cat_id`
+	if err := r.db.SelectContext(ctx, &entries, entryQuery, id); err != nil {
+		return nil, err
+	}
+	run.Entries = entries
+
+	return &run, nil
+}
+
+// ListPayrollRuns retrieves all payroll runs without their entries, latest period first.
+func (r *PayrollRepository) ListPayrollRuns(ctx context.Context) ([]domain.PayrollRun, error) {
+	runs := []domain.PayrollRun{}
+	query := `SELECT id, period_start, period_end, mission_bonus, total, created_at
+			  FROM payroll_runs ORDER BY period_start DESC, id DESC`
+	err := r.db.SelectContext(ctx, &runs, query)
+	return runs, err
+}
@@ -1,16 +1,23 @@
 package postgres
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
 	"spy_cats_agency/internal/config"
+	"spy_cats_agency/internal/repository"
 
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq" // The database driver
 )
 
-// DB is a wrapper for the sqlx.DB that provides database connection.
+// DB is the database connection used by the repositories. It only offers BeginTxx,
+// GetContext, SelectContext, ExecContext and QueryRowxContext, which all run within the
+// transaction carried by their context, if any, so that repositories take part in
+// transactions started by a Transactor. The connection pool is kept unexported so that
+// no query can escape such a transaction; other sqlx methods need a wrapper here first.
 type DB struct {
-	*sqlx.DB
+	pool *sqlx.DB
 }
 
 // New creates a new database connection.
@@ -22,5 +29,103 @@ func New(cfg config.Config) (*DB, error) {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
 
-	return &DB{db}, nil
+	return &DB{pool: db}, nil
+}
+
+// Close closes the connection pool.
+func (db *DB) Close() error {
+	return db.pool.Close()
+}
+
+// txKey is the context key of the transaction started by a Transactor.
+type txKey struct{}
+
+// Tx is a transaction begun by DB.BeginTxx. A transaction that joined the one carried by
+// the context leaves committing and rolling back to the Transactor that started it.
+type Tx struct {
+	*sqlx.Tx
+	joined bool
+}
+
+// Commit commits the transaction, unless it was joined.
+func (tx *Tx) Commit() error {
+	if tx.joined {
+		return nil
+	}
+	return tx.Tx.Commit()
+}
+
+// Rollback aborts the transaction, unless it was joined.
+func (tx *Tx) Rollback() error {
+	if tx.joined {
+		return nil
+	}
+	return tx.Tx.Rollback()
+}
+
+// BeginTxx begins a transaction, or joins the transaction carried by ctx.
+func (db *DB) BeginTxx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
+	if tx, ok := ctx.Value(txKey{}).(*sqlx.Tx); ok {
+		return &Tx{Tx: tx, joined: true}, nil
+	}
+	tx, err := db.pool.BeginTxx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Tx: tx}, nil
+}
+
+// GetContext runs a query that returns a single row within the transaction carried by
+// ctx, if any.
+func (db *DB) GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	return sqlx.GetContext(ctx, db.conn(ctx), dest, query, args...)
+}
+
+// SelectContext runs a query that returns rows within the transaction carried by ctx, if any.
+func (db *DB) SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	return sqlx.SelectContext(ctx, db.conn(ctx), dest, query, args...)
+}
+
+// ExecContext runs a statement within the transaction carried by ctx, if any.
+func (db *DB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return db.conn(ctx).ExecContext(ctx, query, args...)
+}
+
+// QueryRowxContext runs a query that returns a single row within the transaction carried
+// by ctx, if any.
+func (db *DB) QueryRowxContext(ctx context.Context, query string, args ...interface{}) *sqlx.Row {
+	return db.conn(ctx).QueryRowxContext(ctx, query, args...)
+}
+
+// conn returns the transaction carried by ctx, or the connection pool.
+func (db *DB) conn(ctx context.Context) sqlx.ExtContext {
+	if tx, ok := ctx.Value(txKey{}).(*sqlx.Tx); ok {
+		return tx
+	}
+	return db.pool
+}
+
+// Transactor implements the repository.Transactor interface.
+type Transactor struct {
+	db *DB
+}
+
+// NewTransactor creates a new transactor.
+func NewTransactor(db *DB) repository.Transactor {
+	return &Transactor{db: db}
+}
+
+// WithinTx runs fn within a transaction that is committed if fn succeeds. Called within
+// a transaction already, fn joins it instead.
+func (t *Transactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := t.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx.Tx)); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
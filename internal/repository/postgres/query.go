@@ -0,0 +1,125 @@
+package postgres
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"spy_cats_agency/internal/repository"
+	"strings"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 200
+)
+
+// whereBuilder accumulates WHERE conditions and their positional arguments.
+type whereBuilder struct {
+	conditions []string
+	args       []interface{}
+}
+
+// arg registers a query argument and returns its positional placeholder.
+func (b *whereBuilder) arg(v interface{}) string {
+	b.args = append(b.args, v)
+	return fmt.Sprintf("$%d", len(b.args))
+}
+
+// add appends a condition. Placeholders inside it must come from arg.
+func (b *whereBuilder) add(condition string) {
+	b.conditions = append(b.conditions, condition)
+}
+
+// clause renders the accumulated conditions as a WHERE clause, or an empty string.
+func (b *whereBuilder) clause() string {
+	if len(b.conditions) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(b.conditions, " AND ")
+}
+
+// sortColumn describes a column that listings can be sorted and paginated by.
+type sortColumn struct {
+	expr        string // SQL expression used in ORDER BY and keyset comparisons
+	cast        string // Postgres type the cursor value is cast to
+	defaultDesc bool   // Natural order when the caller doesn't pick one
+}
+
+// cursor is the decoded form of an opaque pagination cursor. It records the sort key
+// the page was produced with, the sort value of the last row and its ID as a tiebreaker.
+type cursor struct {
+	SortBy string `json:"s"`
+	Desc   bool   `json:"d"`
+	Value  string `json:"v"`
+	ID     int    `json:"id"`
+}
+
+func encodeCursor(c cursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(s string) (cursor, error) {
+	var c cursor
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, repository.ErrInvalidCursor
+	}
+	if err := json.Unmarshal(data, &c); err != nil {
+		return c, repository.ErrInvalidCursor
+	}
+	return c, nil
+}
+
+// sortDesc resolves the requested sort order against the column's natural order.
+func sortDesc(col sortColumn, order string) (bool, error) {
+	switch strings.ToLower(order) {
+	case "":
+		return col.defaultDesc, nil
+	case "asc":
+		return false, nil
+	case "desc":
+		return true, nil
+	default:
+		return false, fmt.Errorf("invalid sort order: %s", order)
+	}
+}
+
+// applyKeyset adds the condition that skips every row up to and including the cursor
+// position and returns the matching ORDER BY clause. The row ID breaks ties so that
+// pages never overlap.
+func applyKeyset(b *whereBuilder, col sortColumn, sortBy string, desc bool, rawCursor, idColumn string) (string, error) {
+	dir, cmp := "ASC", ">"
+	if desc {
+		dir, cmp = "DESC", "<"
+	}
+
+	if rawCursor != "" {
+		c, err := decodeCursor(rawCursor)
+		if err != nil {
+			return "", err
+		}
+		if c.SortBy != sortBy || c.Desc != desc {
+			return "", fmt.Errorf("%w: it does not match the requested sort order", repository.ErrInvalidCursor)
+		}
+		b.add(fmt.Sprintf("(%s, %s) %s (%s::%s, %s)", col.expr, idColumn, cmp, b.arg(c.Value), col.cast, b.arg(c.ID)))
+	}
+
+	return fmt.Sprintf(" ORDER BY %s %s, %s %s", col.expr, dir, idColumn, dir), nil
+}
+
+// pageLimit clamps a requested page size to the allowed range.
+func pageLimit(limit int) int {
+	if limit <= 0 {
+		return defaultPageSize
+	}
+	if limit > maxPageSize {
+		return maxPageSize
+	}
+	return limit
+}
+
+// likePattern turns a user supplied substring into an escaped ILIKE pattern.
+func likePattern(s string) string {
+	return "%" + strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s) + "%"
+}
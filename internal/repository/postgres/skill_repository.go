@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"spy_cats_agency/internal/domain"
+	"spy_cats_agency/internal/repository"
+
+	"github.com/lib/pq"
+)
+
+// SkillRepository implements the repository.SkillRepository interface.
+type SkillRepository struct {
+	db *DB
+}
+
+// NewSkillRepository creates a new skill repository.
+func NewSkillRepository(db *DB) repository.SkillRepository {
+	return &SkillRepository{db: db}
+}
+
+// CreateSkill adds a skill to the catalog.
+func (r *SkillRepository) CreateSkill(ctx context.Context, skill *domain.Skill) error {
+	query := `INSERT INTO skills (name, description) VALUES ($1, $2) RETURNING id, created_at`
+	return r.db.QueryRowxContext(ctx, query, skill.Name, skill.Description).Scan(&skill.ID, &skill.CreatedAt)
+}
+
+// ListSkills retrieves the whole skill catalog.
+func (r *SkillRepository) ListSkills(ctx context.Context) ([]domain.Skill, error) {
+	skills := []domain.Skill{}
+	query := `SELECT id, name, description, created_at FROM skills ORDER BY name`
+	err := r.db.SelectContext(ctx, &skills, query)
+	return skills, err
+}
+
+// ListCatSkills retrieves the skills of a cat.
+func (r *SkillRepository) ListCatSkills(ctx context.Context, catID int) ([]domain.CatSkill, error) {
+	skills := []domain.CatSkill{}
+	query := `SELECT cs.skill_id, s.name, cs.proficiency
+			  FROM cat_skills cs JOIN skills s ON s.id = cs.skill_id
+			  WHERE cs.cat_id = $1 ORDER BY s.name`
+	err := r.db.SelectContext(ctx, &skills, query, catID)
+	return skills, err
+}
+
+// ListSkillsOfCats retrieves the skills of several cats at once, keyed by cat ID.
+func (r *SkillRepository) ListSkillsOfCats(ctx context.Context, catIDs []int) (map[int][]domain.CatSkill, error) {
+	var rows []struct {
+		CatID int `db:"cat_id"`
+		domain.CatSkill
+	}
+	query := `SELECT cs.cat_id, cs.skill_id, s.name, cs.proficiency
+			  FROM cat_skills cs JOIN skills s ON s.id = cs.skill_id
+			  WHERE cs.cat_id = ANY($1) ORDER BY cs.cat_id, s.name`
+	if err := r.db.SelectContext(ctx, &rows, query, pq.Array(catIDs)); err != nil {
+		return nil, err
+	}
+
+	skills := make(map[int][]domain.CatSkill)
+	for _, row := range rows {
+		skills[row.CatID] = append(skills[row.CatID], row.CatSkill)
+	}
+	return skills, nil
+}
+
+// SetCatSkill gives a cat a skill, or changes its proficiency if it already has it.
+func (r *SkillRepository) SetCatSkill(ctx context.Context, catID, skillID, proficiency int) error {
+	query := `INSERT INTO cat_skills (cat_id, skill_id, proficiency) VALUES ($1, $2, $3)
+			  ON CONFLICT (cat_id, skill_id) DO UPDATE SET proficiency = EXCLUDED.proficiency, updated_at = now()`
+	_, err := r.db.ExecContext(ctx, query, catID, skillID, proficiency)
+	return err
+}
+
+// RemoveCatSkill takes a skill away from a cat.
+func (r *SkillRepository) RemoveCatSkill(ctx context.Context, catID, skillID int) error {
+	query := `DELETE FROM cat_skills WHERE cat_id = $1 AND skill_id = $2`
+	result, err := r.db.ExecContext(ctx, query, catID, skillID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err == nil && rowsAffected == 0 {
+		return fmt.Errorf("cat does not have this skill")
+	}
+	return err
+}
@@ -2,26 +2,96 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"spy_cats_agency/internal/domain"
+	"time"
 )
 
+// Transactor runs a function within a database transaction. Repository calls made with
+// the context passed to the function take part in that transaction.
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
 // CatRepository defines the interface for cat data operations.
 type CatRepository interface {
 	CreateCat(ctx context.Context, cat *domain.Cat) error
+	CreateCats(ctx context.Context, cats []*domain.Cat) error
 	GetCatByID(ctx context.Context, id int) (*domain.Cat, error)
-	ListCats(ctx context.Context) ([]domain.Cat, error)
-	UpdateCat(ctx context.Context, cat *domain.Cat) error
-	DeleteCat(ctx context.Context, id int) error
+	ListCats(ctx context.Context, filter domain.CatFilter) (*domain.CatPage, error)
+	UpdateCat(ctx context.Context, cat *domain.Cat, salaryChange *domain.SalaryChange) error
+	ListSalaryChanges(ctx context.Context, catID int) ([]domain.SalaryChange, error)
+	ListSalaryChangesUntil(ctx context.Context, until time.Time) ([]domain.SalaryChange, error)
+	GetCatStats(ctx context.Context, catID int) (*domain.CatStats, error)
+	ListCatStats(ctx context.Context, sortBy string, limit int) ([]domain.CatStats, error)
+	UpdateCatStatus(ctx context.Context, cat *domain.Cat, from domain.CatStatus) error
+	RetireCat(ctx context.Context, id int, reason string) error
+	RestoreCat(ctx context.Context, cat *domain.Cat) error
+	ListRetirementsUntil(ctx context.Context, until time.Time) ([]domain.CatRetirement, error)
+}
+
+// MissionTemplateRepository defines the interface for mission template data operations.
+type MissionTemplateRepository interface {
+	CreateTemplate(ctx context.Context, template *domain.MissionTemplate) error
+	GetTemplateByID(ctx context.Context, id int) (*domain.MissionTemplate, error)
+	ListTemplates(ctx context.Context) ([]domain.MissionTemplate, error)
+	UpdateTemplate(ctx context.Context, template *domain.MissionTemplate) error
+	DeleteTemplate(ctx context.Context, id int) error
+}
+
+// LeaveRepository defines the interface for cat leave data operations.
+type LeaveRepository interface {
+	CreateLeave(ctx context.Context, leave *domain.Leave, today time.Time) error
+	ListLeaves(ctx context.Context, catID int, from, to time.Time) ([]domain.Leave, error)
+	SyncLeaveStatuses(ctx context.Context, today time.Time, catID *int) (started, ended int, err error)
+}
+
+// ExpenseRepository defines the interface for mission budget and expense data operations.
+type ExpenseRepository interface {
+	CreateExpense(ctx context.Context, expense *domain.Expense, allowOverBudget bool) error
+	ListExpenses(ctx context.Context, missionID int) ([]domain.Expense, error)
+	SetBudget(ctx context.Context, missionID int, budget *float64) error
 }
 
+// MissionEventRepository defines the interface for mission timeline data operations.
+type MissionEventRepository interface {
+	CreateEvent(ctx context.Context, event *domain.MissionEvent) error
+	ListEvents(ctx context.Context, missionID int) ([]domain.MissionEvent, error)
+}
+
+// ErrInvalidCursor is returned when a pagination cursor is malformed or was produced for
+// another sort order.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// ErrMissionCodeTaken is returned when the codename or reference code of a new mission
+// is already used by another mission.
+var ErrMissionCodeTaken = errors.New("mission codename or code is already taken")
+
 // MissionRepository defines the interface for mission data operations.
 type MissionRepository interface {
 	CreateMission(ctx context.Context, mission *domain.Mission) error
 	GetMissionByID(ctx context.Context, id int) (*domain.Mission, error)
-	ListMissions(ctx context.Context) ([]domain.Mission, error)
+	GetMissionByCode(ctx context.Context, code string) (*domain.Mission, error)
+	CloneMission(ctx context.Context, sourceID int, keepNotes bool, codename, code string) (int, error)
+	ListMissions(ctx context.Context, filter domain.MissionFilter) (*domain.MissionPage, error)
+	FlagOverdueMissions(ctx context.Context, now time.Time) ([]domain.Mission, error)
+	ListOverdueMissions(ctx context.Context, now time.Time) ([]domain.Mission, error)
+	ListMissionsByCat(ctx context.Context, catID int) ([]domain.Mission, error)
 	UpdateMission(ctx context.Context, mission *domain.Mission) error
+	UpdateMissionStatus(ctx context.Context, mission *domain.Mission, from domain.MissionStatus) error
 	DeleteMission(ctx context.Context, id int) error
-	AssignCatToMission(ctx context.Context, missionID, catID int) error
+	ReassignCat(ctx context.Context, mission *domain.Mission, from *int, fromStatus domain.MissionStatus) error
+	AddTeamMember(ctx context.Context, member *domain.TeamMember) error
+	RemoveTeamMember(ctx context.Context, missionID, catID int) error
+	ListTeamHistory(ctx context.Context, missionID int) ([]domain.TeamMember, error)
+	AddDependency(ctx context.Context, dependency domain.MissionDependency) error
+	RemoveDependency(ctx context.Context, dependency domain.MissionDependency) error
+	GetDependencyGraph(ctx context.Context, missionID int) (*domain.MissionDependencyGraph, error)
+	ListUnmetPrerequisites(ctx context.Context, missionID int) ([]int, error)
+	CountCompletedMissionsByCat(ctx context.Context, from, to time.Time) (map[int]int, error)
+	SetRequiredSkills(ctx context.Context, missionID int, requirements []domain.SkillRequirement) error
+	CountCompletedTargetsByCat(ctx context.Context, countries []string) (map[int]int, error)
+	CountRecentMissionsByCat(ctx context.Context, since time.Time) (map[int]int, error)
 }
 
 // TargetRepository defines the interface for target data operations.
@@ -32,3 +102,21 @@ type TargetRepository interface {
 	DeleteTarget(ctx context.Context, id int) error
 	GetTargetsByMissionID(ctx context.Context, missionID int) ([]domain.Target, error)
 }
+
+// SkillRepository defines the interface for the skill catalog and cat skills.
+type SkillRepository interface {
+	CreateSkill(ctx context.Context, skill *domain.Skill) error
+	ListSkills(ctx context.Context) ([]domain.Skill, error)
+	ListCatSkills(ctx context.Context, catID int) ([]domain.CatSkill, error)
+	ListSkillsOfCats(ctx context.Context, catIDs []int) (map[int][]domain.CatSkill, error)
+	SetCatSkill(ctx context.Context, catID, skillID, proficiency int) error
+	RemoveCatSkill(ctx context.Context, catID, skillID int) error
+}
+
+// PayrollRepository defines the interface for payroll data operations.
+// Payroll runs are immutable, so there are no update or delete operations.
+type PayrollRepository interface {
+	CreatePayrollRun(ctx context.Context, run *domain.PayrollRun) error
+	GetPayrollRunByID(ctx context.Context, id int) (*domain.PayrollRun, error)
+	ListPayrollRuns(ctx context.Context) ([]domain.PayrollRun, error)
+}
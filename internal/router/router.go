@@ -12,10 +12,15 @@ import (
 
 // Config holds the dependencies needed for route setup.
 type Config struct {
-	CatHandler     *handler.CatHandler
-	MissionHandler *handler.MissionHandler
-	TargetHandler  *handler.TargetHandler
-	Logger         *slog.Logger
+	CatHandler             *handler.CatHandler
+	MissionHandler         *handler.MissionHandler
+	TargetHandler          *handler.TargetHandler
+	SkillHandler           *handler.SkillHandler
+	PayrollHandler         *handler.PayrollHandler
+	LeaveHandler           *handler.LeaveHandler
+	ExpenseHandler         *handler.ExpenseHandler
+	MissionTemplateHandler *handler.MissionTemplateHandler
+	Logger                 *slog.Logger
 }
 
 // Setup initializes and configures all routes.
@@ -24,6 +29,7 @@ func Setup(cfg Config) *gin.Engine {
 	router.Use(gin.Recovery())
 	router.Use(logger.Middleware(cfg.Logger))
 	router.Use(handler.ErrorMiddleware(cfg.Logger))
+	router.Use(handler.ActorMiddleware())
 
 	// Swagger documentation
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
@@ -46,6 +52,11 @@ func setupAPIRoutes(router *gin.Engine, cfg Config) {
 		setupCatRoutes(api, cfg.CatHandler)
 		setupMissionRoutes(api, cfg.MissionHandler, cfg.TargetHandler)
 		setupTargetRoutes(api, cfg.TargetHandler)
+		setupSkillRoutes(api, cfg.SkillHandler)
+		setupPayrollRoutes(api, cfg.PayrollHandler)
+		setupLeaveRoutes(api, cfg.LeaveHandler)
+		setupExpenseRoutes(api, cfg.ExpenseHandler)
+		setupMissionTemplateRoutes(api, cfg.MissionTemplateHandler)
 	}
 }
 
@@ -55,9 +66,17 @@ func setupCatRoutes(api *gin.RouterGroup, catHandler *handler.CatHandler) {
 	{
 		cats.POST("", catHandler.CreateCat)
 		cats.GET("", catHandler.ListCats)
+		cats.POST("/import", catHandler.ImportCats)
+		cats.GET("/leaderboard", catHandler.GetLeaderboard)
 		cats.GET("/:id", catHandler.GetCat)
+		cats.GET("/:id/dossier", catHandler.GetCatDossier)
+		cats.GET("/:id/stats", catHandler.GetCatStats)
+		cats.PATCH("/:id", catHandler.UpdateCat)
 		cats.PATCH("/:id/salary", catHandler.UpdateCatSalary)
-		cats.DELETE("/:id", catHandler.DeleteCat)
+		cats.GET("/:id/salary-history", catHandler.GetSalaryHistory)
+		cats.POST("/:id/status", catHandler.ChangeCatStatus)
+		cats.DELETE("/:id", catHandler.RetireCat)
+		cats.POST("/:id/restore", catHandler.RestoreCat)
 	}
 }
 
@@ -67,12 +86,28 @@ func setupMissionRoutes(api *gin.RouterGroup, missionHandler *handler.MissionHan
 	{
 		missions.POST("", missionHandler.CreateMission)
 		missions.GET("", missionHandler.ListMissions)
+		missions.GET("/overdue", missionHandler.ListOverdueMissions)
+		missions.GET("/by-code/:code", missionHandler.GetMissionByCode)
 		missions.GET("/:id", missionHandler.GetMission)
 		missions.DELETE("/:id", missionHandler.DeleteMission)
+		missions.POST("/:id/clone", missionHandler.CloneMission)
 		missions.PATCH("/:id/assign-cat", missionHandler.AssignCatToMission)
+		missions.PATCH("/:id/cat", missionHandler.ReassignCat)
+		missions.POST("/:id/team", missionHandler.AddTeamMember)
+		missions.DELETE("/:id/team/:catId", missionHandler.RemoveTeamMember)
+		missions.GET("/:id/briefing", missionHandler.GetBriefing)
+		missions.GET("/:id/timeline", missionHandler.GetTimeline)
+		missions.GET("/:id/dependencies", missionHandler.GetDependencies)
+		missions.POST("/:id/dependencies", missionHandler.AddDependency)
+		missions.DELETE("/:id/dependencies/:prerequisiteId", missionHandler.RemoveDependency)
 		missions.PATCH("/:id/complete", missionHandler.CompleteMission)
+		missions.POST("/:id/status", missionHandler.ChangeMissionStatus)
+		missions.PUT("/:id/required-skills", missionHandler.SetRequiredSkills)
+		missions.GET("/:id/candidates", missionHandler.ListCandidates)
 		missions.POST("/:id/targets", targetHandler.AddTargetToMission)
 	}
+
+	api.GET("/cats/:id/missions", missionHandler.ListCatMissions)
 }
 
 // setupTargetRoutes configures target-related routes.
@@ -84,3 +119,64 @@ func setupTargetRoutes(api *gin.RouterGroup, targetHandler *handler.TargetHandle
 		targets.DELETE("/:id", targetHandler.DeleteTarget)
 	}
 }
+
+// setupSkillRoutes configures the skill catalog and cat skill routes.
+func setupSkillRoutes(api *gin.RouterGroup, skillHandler *handler.SkillHandler) {
+	skills := api.Group("/skills")
+	{
+		skills.POST("", skillHandler.CreateSkill)
+		skills.GET("", skillHandler.ListSkills)
+	}
+
+	catSkills := api.Group("/cats/:id/skills")
+	{
+		catSkills.GET("", skillHandler.ListCatSkills)
+		catSkills.PUT("/:skillId", skillHandler.SetCatSkill)
+		catSkills.DELETE("/:skillId", skillHandler.RemoveCatSkill)
+	}
+}
+
+// setupMissionTemplateRoutes configures mission template routes.
+func setupMissionTemplateRoutes(api *gin.RouterGroup, templateHandler *handler.MissionTemplateHandler) {
+	templates := api.Group("/mission-templates")
+	{
+		templates.POST("", templateHandler.CreateTemplate)
+		templates.GET("", templateHandler.ListTemplates)
+		templates.GET("/:id", templateHandler.GetTemplate)
+		templates.PUT("/:id", templateHandler.UpdateTemplate)
+		templates.DELETE("/:id", templateHandler.DeleteTemplate)
+	}
+
+	api.POST("/missions/from-template/:id", templateHandler.CreateMissionFromTemplate)
+}
+
+// setupLeaveRoutes configures cat leave and availability routes.
+func setupLeaveRoutes(api *gin.RouterGroup, leaveHandler *handler.LeaveHandler) {
+	cats := api.Group("/cats/:id")
+	{
+		cats.POST("/leave", leaveHandler.ScheduleLeave)
+		cats.GET("/availability", leaveHandler.GetAvailability)
+	}
+}
+
+// setupExpenseRoutes configures mission budget and expense routes.
+func setupExpenseRoutes(api *gin.RouterGroup, expenseHandler *handler.ExpenseHandler) {
+	missions := api.Group("/missions/:id")
+	{
+		missions.PUT("/budget", expenseHandler.SetBudget)
+		missions.POST("/expenses", expenseHandler.RecordExpense)
+		missions.GET("/expenses", expenseHandler.ListExpenses)
+		missions.GET("/finance", expenseHandler.GetFinance)
+	}
+}
+
+// setupPayrollRoutes configures payroll-related routes.
+func setupPayrollRoutes(api *gin.RouterGroup, payrollHandler *handler.PayrollHandler) {
+	runs := api.Group("/payroll/runs")
+	{
+		runs.POST("", payrollHandler.CreatePayrollRun)
+		runs.GET("", payrollHandler.ListPayrollRuns)
+		runs.GET("/:id", payrollHandler.GetPayrollRun)
+		runs.GET("/:id/export", payrollHandler.ExportPayrollRun)
+	}
+}
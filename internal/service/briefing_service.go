@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"spy_cats_agency/internal/briefing"
+	"spy_cats_agency/internal/domain"
+	"time"
+)
+
+// briefingService is the implementation of the BriefingService interface.
+type briefingService struct {
+	missionService MissionService
+	catService     CatService
+	renderer       *briefing.Renderer
+}
+
+// NewBriefingService creates a new BriefingService.
+func NewBriefingService(missionService MissionService, catService CatService, renderer *briefing.Renderer) BriefingService {
+	return &briefingService{
+		missionService: missionService,
+		catService:     catService,
+		renderer:       renderer,
+	}
+}
+
+// RenderBriefing renders the briefing of a mission in the given format. If the breed
+// details of the assigned cat can't be fetched, the briefing is rendered without them.
+func (s *briefingService) RenderBriefing(ctx context.Context, missionID int, format string) ([]byte, error) {
+	mission, err := s.missionService.GetMission(ctx, missionID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := briefing.Data{Mission: *mission, GeneratedAt: time.Now()}
+	if mission.CatID != nil {
+		data.Cat, err = s.catService.GetCatDossier(ctx, *mission.CatID)
+		if err != nil {
+			cat, err := s.catService.GetCat(ctx, *mission.CatID)
+			if err != nil {
+				return nil, err
+			}
+			data.Cat = &domain.CatDossier{Cat: *cat}
+		}
+	}
+
+	return s.renderer.Render(format, data)
+}
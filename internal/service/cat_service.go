@@ -10,29 +10,162 @@ import (
 
 // catService is the implementation of the CatService interface.
 type catService struct {
-	catRepo    repository.CatRepository
+	catRepo      repository.CatRepository
 	catAPIClient *catapi.Client
 }
 
 // NewCatService creates a new CatService.
 func NewCatService(catRepo repository.CatRepository, catAPIClient *catapi.Client) CatService {
 	return &catService{
-		catRepo:    catRepo,
+		catRepo:      catRepo,
 		catAPIClient: catAPIClient,
 	}
 }
 
 // CreateCat validates the breed and creates a new cat.
 func (s *catService) CreateCat(ctx context.Context, cat *domain.Cat) error {
-	valid, err := s.catAPIClient.IsValidBreed(cat.Breed)
+	if err := s.validateBreed(cat.Breed); err != nil {
+		return err
+	}
+
+	return s.catRepo.CreateCat(ctx, cat)
+}
+
+// ImportCats creates the cats of several import rows. Breeds are checked against a single
+// fetch of the breed list. In atomic mode nothing is created unless every row is valid,
+// in best effort mode each valid row is created on its own and failures are reported.
+func (s *catService) ImportCats(ctx context.Context, rows []domain.CatImportRow, mode domain.ImportMode) (*domain.CatImportReport, error) {
+	if mode != domain.ImportModeAtomic && mode != domain.ImportModeBestEffort {
+		return nil, fmt.Errorf("invalid import mode: %s", mode)
+	}
+
+	breeds, err := s.catAPIClient.GetBreeds()
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate breed: %w", err)
+	}
+	validBreeds := make(map[string]bool, len(breeds))
+	for _, breed := range breeds {
+		validBreeds[breed.Name] = true
+	}
+
+	report := &domain.CatImportReport{Mode: mode, Rows: rows}
+	var valid []*domain.CatImportRow
+	for i := range rows {
+		row := &rows[i]
+		if row.Error == "" && row.Cat == nil {
+			row.Error = "missing cat"
+		}
+		if row.Error == "" && !validBreeds[row.Cat.Breed] {
+			row.Error = fmt.Sprintf("invalid cat breed: %s", row.Cat.Breed)
+		}
+		if row.Error != "" {
+			report.Failed++
+			continue
+		}
+		valid = append(valid, row)
+	}
+
+	switch mode {
+	case domain.ImportModeAtomic:
+		if report.Failed > 0 {
+			return report, nil
+		}
+		cats := make([]*domain.Cat, len(valid))
+		for i, row := range valid {
+			cats[i] = row.Cat
+		}
+		if err := s.catRepo.CreateCats(ctx, cats); err != nil {
+			return nil, err
+		}
+		for _, row := range valid {
+			row.CatID = &row.Cat.ID
+			report.Created++
+		}
+	case domain.ImportModeBestEffort:
+		for _, row := range valid {
+			if err := s.catRepo.CreateCat(ctx, row.Cat); err != nil {
+				row.Error = err.Error()
+				report.Failed++
+				continue
+			}
+			row.CatID = &row.Cat.ID
+			report.Created++
+		}
+	}
+
+	return report, nil
+}
+
+// GetCatDossier retrieves a cat together with its breed details and a picture of the
+// breed from TheCatAPI. The picture is best effort: if the image search fails, the
+// breed's reference image is used instead.
+func (s *catService) GetCatDossier(ctx context.Context, id int) (*domain.CatDossier, error) {
+	cat, err := s.catRepo.GetCatByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	breed, err := s.catAPIClient.GetBreedByName(cat.Breed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch breed details: %w", err)
+	}
+
+	dossier := &domain.CatDossier{Cat: *cat}
+	if breed == nil {
+		return dossier, nil
+	}
+
+	dossier.Breed = &domain.BreedDetails{
+		ID:           breed.ID,
+		Name:         breed.Name,
+		Description:  breed.Description,
+		Temperament:  breed.Temperament,
+		Origin:       breed.Origin,
+		LifeSpan:     breed.LifeSpan,
+		WeightMetric: breed.Weight.Metric,
+		WikipediaURL: breed.WikipediaURL,
+	}
+	if images, err := s.catAPIClient.SearchBreedImages(breed.ID, 1); err == nil && len(images) > 0 {
+		dossier.ImageURL = images[0].URL
+	} else if breed.Image != nil {
+		dossier.ImageURL = breed.Image.URL
+	}
+
+	return dossier, nil
+}
+
+// GetCatStats retrieves the performance statistics of a cat.
+func (s *catService) GetCatStats(ctx context.Context, id int) (*domain.CatStats, error) {
+	return s.catRepo.GetCatStats(ctx, id)
+}
+
+// GetLeaderboard ranks active cats by their performance statistics. An empty sortBy
+// ranks them by completed missions.
+func (s *catService) GetLeaderboard(ctx context.Context, sortBy string, limit int) ([]domain.CatStats, error) {
+	if sortBy == "" {
+		sortBy = domain.CatStatsSortMissionsCompleted
+	}
+
+	stats, err := s.catRepo.ListCatStats(ctx, sortBy, limit)
+	if err != nil {
+		return nil, err
+	}
+	for i := range stats {
+		stats[i].Rank = i + 1
+	}
+	return stats, nil
+}
+
+// validateBreed checks a breed name against TheCatAPI.
+func (s *catService) validateBreed(breed string) error {
+	valid, err := s.catAPIClient.IsValidBreed(breed)
 	if err != nil {
 		return fmt.Errorf("failed to validate breed: %w", err)
 	}
 	if !valid {
-		return fmt.Errorf("invalid cat breed: %s", cat.Breed)
+		return fmt.Errorf("invalid cat breed: %s", breed)
 	}
-
-	return s.catRepo.CreateCat(ctx, cat)
+	return nil
 }
 
 // GetCat retrieves a cat by its ID.
@@ -40,27 +173,141 @@ func (s *catService) GetCat(ctx context.Context, id int) (*domain.Cat, error) {
 	return s.catRepo.GetCatByID(ctx, id)
 }
 
-// ListCats retrieves all cats.
-func (s *catService) ListCats(ctx context.Context) ([]domain.Cat, error) {
-	return s.catRepo.ListCats(ctx)
+// ListCats retrieves a filtered, sorted page of cats.
+func (s *catService) ListCats(ctx context.Context, filter domain.CatFilter) (*domain.CatPage, error) {
+	return s.catRepo.ListCats(ctx, filter)
 }
 
-// UpdateCatSalary updates a cat's salary.
-func (s *catService) UpdateCatSalary(ctx context.Context, id int, salary float64) (*domain.Cat, error) {
+// UpdateCat applies a partial update to a cat's profile. A changed breed is
+// re-validated against TheCatAPI before anything is written.
+func (s *catService) UpdateCat(ctx context.Context, id int, update domain.CatUpdate) (*domain.Cat, error) {
 	cat, err := s.catRepo.GetCatByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	cat.Salary = salary
-	if err := s.catRepo.UpdateCat(ctx, cat); err != nil {
+	if update.Breed != nil && *update.Breed != cat.Breed {
+		if err := s.validateBreed(*update.Breed); err != nil {
+			return nil, err
+		}
+		cat.Breed = *update.Breed
+	}
+	if update.Name != nil {
+		cat.Name = *update.Name
+	}
+	if update.YearsOfExperience != nil {
+		cat.YearsOfExperience = *update.YearsOfExperience
+	}
+	if update.Salary != nil {
+		cat.Salary = *update.Salary
+	}
+
+	change := &domain.SalaryChange{Reason: update.SalaryReason}
+	if err := s.catRepo.UpdateCat(ctx, cat, change); err != nil {
+		return nil, err
+	}
+
+	return cat, nil
+}
+
+// UpdateCatSalary updates a cat's salary and records the change in its salary history.
+// A zero EffectiveDate makes the change effective today.
+func (s *catService) UpdateCatSalary(ctx context.Context, id int, change domain.SalaryChange) (*domain.Cat, error) {
+	cat, err := s.catRepo.GetCatByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	cat.Salary = change.NewSalary
+	if err := s.catRepo.UpdateCat(ctx, cat, &change); err != nil {
 		return nil, err
 	}
 
 	return cat, nil
 }
 
-// DeleteCat deletes a cat.
-func (s *catService) DeleteCat(ctx context.Context, id int) error {
-	return s.catRepo.DeleteCat(ctx, id)
+// GetSalaryHistory retrieves a cat's salary history, oldest first.
+func (s *catService) GetSalaryHistory(ctx context.Context, id int) ([]domain.SalaryChange, error) {
+	if _, err := s.catRepo.GetCatByID(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.catRepo.ListSalaryChanges(ctx, id)
+}
+
+// RetireCat archives a cat instead of deleting it, so its mission history stays intact.
+// Cats with an active mission cannot be retired.
+func (s *catService) RetireCat(ctx context.Context, id int, reason string) error {
+	cat, err := s.catRepo.GetCatByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if cat.ArchivedAt != nil {
+		return fmt.Errorf("cat is already retired")
+	}
+	if !cat.Status.CanTransitionTo(domain.CatStatusRetired) {
+		return fmt.Errorf("a cat that is %s cannot be retired", cat.Status)
+	}
+	return s.catRepo.RetireCat(ctx, id, reason)
+}
+
+// ChangeCatStatus moves a cat to a new status according to the status transition rules.
+// Missions, leave periods and retirement own their statuses, so cats can't be put on a
+// mission, released from one, sent on leave, retired or restored this way.
+func (s *catService) ChangeCatStatus(ctx context.Context, id int, status domain.CatStatus) (*domain.Cat, error) {
+	if !status.IsValid() {
+		return nil, fmt.Errorf("invalid cat status: %s", status)
+	}
+
+	cat, err := s.catRepo.GetCatByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case status == domain.CatStatusOnMission:
+		return nil, fmt.Errorf("cats are put on a mission by assigning them to it")
+	case status == domain.CatStatusOnLeave:
+		return nil, fmt.Errorf("cats go on leave by scheduling a leave period")
+	case status == domain.CatStatusRetired || cat.Status == domain.CatStatusRetired:
+		return nil, fmt.Errorf("cats are retired and restored through their own endpoints")
+	case cat.Status == domain.CatStatusOnMission && status == domain.CatStatusAvailable:
+		return nil, fmt.Errorf("cats are released when their mission ends")
+	case !cat.Status.CanTransitionTo(status):
+		return nil, fmt.Errorf("cannot change cat status from %s to %s", cat.Status, status)
+	}
+
+	from := cat.Status
+	cat.Status = status
+	if err := s.catRepo.UpdateCatStatus(ctx, cat, from); err != nil {
+		return nil, err
+	}
+	return cat, nil
+}
+
+// RestoreCat returns a retired cat to active duty.
+func (s *catService) RestoreCat(ctx context.Context, id int) (*domain.Cat, error) {
+	cat, err := s.catRepo.GetCatByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.catRepo.RestoreCat(ctx, cat); err != nil {
+		return nil, err
+	}
+	return cat, nil
+}
+
+// listAllCats walks every page of a cat listing and returns the combined result.
+func listAllCats(ctx context.Context, catRepo repository.CatRepository, filter domain.CatFilter) ([]domain.Cat, error) {
+	var cats []domain.Cat
+	for {
+		page, err := catRepo.ListCats(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		cats = append(cats, page.Cats...)
+		if page.NextCursor == "" {
+			return cats, nil
+		}
+		filter.Cursor = page.NextCursor
+	}
 }
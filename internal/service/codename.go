@@ -0,0 +1,108 @@
+package service
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"spy_cats_agency/internal/repository"
+)
+
+// Words that mission codenames are made of. Every combination must read as a plausible
+// operation name.
+var (
+	codenameAdjectives = []string{
+		"Amber", "Arctic", "Ashen", "Azure", "Bitter", "Black", "Blazing", "Bold",
+		"Brass", "Bronze", "Burning", "Cobalt", "Crimson", "Crystal", "Dark", "Distant",
+		"Dusk", "Emerald", "Falling", "Frozen", "Ghost", "Gilded", "Golden", "Granite",
+		"Grey", "Hidden", "Hollow", "Iron", "Ivory", "Jade", "Lone", "Lunar",
+		"Midnight", "Misty", "Northern", "Obsidian", "Pale", "Quiet", "Rapid", "Scarlet",
+		"Shadow", "Silent", "Silver", "Solar", "Steel", "Stone", "Velvet", "Winter",
+	}
+	codenameNouns = []string{
+		"Anchor", "Arrow", "Badger", "Beacon", "Blade", "Cobra", "Comet", "Compass",
+		"Condor", "Coyote", "Dagger", "Eclipse", "Ember", "Falcon", "Fox", "Gale",
+		"Harbor", "Hawk", "Heron", "Horizon", "Jaguar", "Lantern", "Lynx", "Mantis",
+		"Meridian", "Mirage", "Monsoon", "Nomad", "Oracle", "Owl", "Panther", "Phantom",
+		"Pike", "Prism", "Quarry", "Raven", "Sentinel", "Serpent", "Sparrow", "Spectre",
+		"Tempest", "Thistle", "Tiger", "Torrent", "Viper", "Vortex", "Whisper", "Wolf",
+	}
+)
+
+// missionCodeAlphabet leaves out characters that are easily confused when read aloud
+// or handwritten, such as 0 and O or 1 and I.
+const (
+	missionCodeAlphabet = "23456789ABCDEFGHJKLMNPQRSTUVWXYZ"
+	missionCodeLength   = 6
+)
+
+// How often a mission is saved with fresh names before giving up, and after how many
+// attempts codenames get a numeric suffix.
+const (
+	missionCodeAttempts     = 8
+	missionCodenameSuffixes = 3
+)
+
+// withMissionCodes calls save with a newly generated codename and reference code until
+// it doesn't fail with repository.ErrMissionCodeTaken.
+func withMissionCodes(save func(codename, code string) error) error {
+	for attempt := 0; attempt < missionCodeAttempts; attempt++ {
+		codename, err := newMissionCodename(attempt >= missionCodenameSuffixes)
+		if err != nil {
+			return err
+		}
+		code, err := newMissionCode()
+		if err != nil {
+			return err
+		}
+
+		if err := save(codename, code); !errors.Is(err, repository.ErrMissionCodeTaken) {
+			return err
+		}
+	}
+	return fmt.Errorf("failed to generate a unique mission codename and code")
+}
+
+// newMissionCodename picks a random codename such as "Silent Falcon". With a suffix,
+// a random number is appended to widen the choice once plain names start colliding.
+func newMissionCodename(suffix bool) (string, error) {
+	adjective, err := randomIndex(len(codenameAdjectives))
+	if err != nil {
+		return "", err
+	}
+	noun, err := randomIndex(len(codenameNouns))
+	if err != nil {
+		return "", err
+	}
+
+	codename := codenameAdjectives[adjective] + " " + codenameNouns[noun]
+	if suffix {
+		n, err := randomIndex(90)
+		if err != nil {
+			return "", err
+		}
+		codename = fmt.Sprintf("%s %d", codename, n+10)
+	}
+	return codename, nil
+}
+
+// newMissionCode generates a random reference code such as "K7QM2X".
+func newMissionCode() (string, error) {
+	code := make([]byte, missionCodeLength)
+	for i := range code {
+		n, err := randomIndex(len(missionCodeAlphabet))
+		if err != nil {
+			return "", err
+		}
+		code[i] = missionCodeAlphabet[n]
+	}
+	return string(code), nil
+}
+
+func randomIndex(n int) (int, error) {
+	i, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, fmt.Errorf("failed to generate random number: %w", err)
+	}
+	return int(i.Int64()), nil
+}
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"spy_cats_agency/internal/domain"
+	"spy_cats_agency/internal/repository"
+	"time"
+)
+
+// expenseService is the implementation of the ExpenseService interface.
+type expenseService struct {
+	expenseRepo repository.ExpenseRepository
+	missionRepo repository.MissionRepository
+	catRepo     repository.CatRepository
+}
+
+// NewExpenseService creates a new ExpenseService.
+func NewExpenseService(expenseRepo repository.ExpenseRepository, missionRepo repository.MissionRepository, catRepo repository.CatRepository) ExpenseService {
+	return &expenseService{
+		expenseRepo: expenseRepo,
+		missionRepo: missionRepo,
+		catRepo:     catRepo,
+	}
+}
+
+// SetBudget replaces the budget of a mission. A nil budget removes the limit. Lowering the
+// budget below what was already spent is allowed, it only blocks further expenses.
+func (s *expenseService) SetBudget(ctx context.Context, missionID int, budget *float64) (*domain.Mission, error) {
+	if budget != nil && *budget < 0 {
+		return nil, fmt.Errorf("mission budget must not be negative")
+	}
+	if err := s.expenseRepo.SetBudget(ctx, missionID, budget); err != nil {
+		return nil, err
+	}
+	return s.missionRepo.GetMissionByID(ctx, missionID)
+}
+
+// RecordExpense adds an expense to the ledger of a mission. Expenses default to today
+// and can't be dated in the future. An expense that would exceed the mission's budget
+// is rejected unless override is set.
+func (s *expenseService) RecordExpense(ctx context.Context, expense *domain.Expense, override bool) error {
+	if expense.Amount <= 0 {
+		return fmt.Errorf("expense amount must be positive")
+	}
+	if expense.Category == "" {
+		expense.Category = domain.ExpenseCategoryOther
+	}
+	if !expense.Category.IsValid() {
+		return fmt.Errorf("invalid expense category: %s", expense.Category)
+	}
+
+	today := dateOf(time.Now())
+	if expense.SpentOn.IsZero() {
+		expense.SpentOn = today
+	}
+	expense.SpentOn = dateOf(expense.SpentOn)
+	if expense.SpentOn.After(today) {
+		return fmt.Errorf("expense date must not be in the future")
+	}
+
+	expense.Amount = roundCents(expense.Amount)
+	return s.expenseRepo.CreateExpense(ctx, expense, override)
+}
+
+// ListExpenses retrieves the expense ledger of a mission.
+func (s *expenseService) ListExpenses(ctx context.Context, missionID int) ([]domain.Expense, error) {
+	if _, err := s.missionRepo.GetMissionByID(ctx, missionID); err != nil {
+		return nil, fmt.Errorf("mission not found: %w", err)
+	}
+	return s.expenseRepo.ListExpenses(ctx, missionID)
+}
+
+// GetFinance summarizes the expenses of a mission against its budget, together with the
+// salary cost of its team. Every cat is charged for the days it was on the team while
+// the mission was running, from the later of its joining and the start of the mission
+// until it left, the mission ended or today. Salaries are prorated from each cat's
+// salary history, and the day of a handover is charged to both cats.
+func (s *expenseService) GetFinance(ctx context.Context, missionID int) (*domain.MissionFinance, error) {
+	mission, err := s.missionRepo.GetMissionByID(ctx, missionID)
+	if err != nil {
+		return nil, fmt.Errorf("mission not found: %w", err)
+	}
+	expenses, err := s.expenseRepo.ListExpenses(ctx, missionID)
+	if err != nil {
+		return nil, err
+	}
+
+	finance := &domain.MissionFinance{
+		MissionID:  mission.ID,
+		Budget:     mission.Budget,
+		ByCategory: make(map[string]float64),
+	}
+	for _, e := range expenses {
+		finance.Spent = roundCents(finance.Spent + e.Amount)
+		finance.ByCategory[string(e.Category)] = roundCents(finance.ByCategory[string(e.Category)] + e.Amount)
+	}
+	if mission.Budget != nil {
+		remaining := roundCents(*mission.Budget - finance.Spent)
+		finance.Remaining = &remaining
+	}
+	finance.TotalCost = finance.Spent
+
+	finance.Salaries = []domain.MissionSalaryCost{}
+	if mission.StartedAt == nil {
+		return finance, nil
+	}
+
+	team, err := s.missionRepo.ListTeamHistory(ctx, missionID)
+	if err != nil {
+		return nil, err
+	}
+	missionEnd := time.Now()
+	if mission.EndedAt != nil {
+		missionEnd = *mission.EndedAt
+	}
+
+	changesByCat := make(map[int][]domain.SalaryChange)
+	for _, member := range team {
+		start, end := member.JoinedAt, missionEnd
+		if start.Before(*mission.StartedAt) {
+			start = *mission.StartedAt
+		}
+		if member.LeftAt != nil && member.LeftAt.Before(end) {
+			end = *member.LeftAt
+		}
+		if end.Before(start) {
+			continue
+		}
+
+		changes, ok := changesByCat[member.CatID]
+		if !ok {
+			if changes, err = s.catRepo.ListSalaryChanges(ctx, member.CatID); err != nil {
+				return nil, err
+			}
+			changesByCat[member.CatID] = changes
+		}
+
+		from, to := dateOf(start), dateOf(end)
+		days, cost := proratedPay(changes, from, to)
+		finance.Salaries = append(finance.Salaries, domain.MissionSalaryCost{
+			CatID: member.CatID,
+			Role:  member.Role,
+			From:  from,
+			To:    to,
+			Days:  days,
+			Cost:  roundCents(cost),
+		})
+		finance.SalaryCost = roundCents(finance.SalaryCost + roundCents(cost))
+	}
+	finance.TotalCost = roundCents(finance.TotalCost + finance.SalaryCost)
+
+	return finance, nil
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"spy_cats_agency/internal/domain"
+	"spy_cats_agency/internal/repository"
+	"time"
+)
+
+// leaveService is the implementation of the LeaveService interface.
+type leaveService struct {
+	leaveRepo  repository.LeaveRepository
+	catRepo    repository.CatRepository
+	transactor repository.Transactor
+	logger     *slog.Logger
+}
+
+// NewLeaveService creates a new LeaveService.
+func NewLeaveService(leaveRepo repository.LeaveRepository, catRepo repository.CatRepository, transactor repository.Transactor, logger *slog.Logger) LeaveService {
+	return &leaveService{
+		leaveRepo:  leaveRepo,
+		catRepo:    catRepo,
+		transactor: transactor,
+		logger:     logger,
+	}
+}
+
+// ScheduleLeave schedules a leave period for a cat. Periods of the same cat can't overlap,
+// and a cat on a mission can't go on leave before the mission ends. A leave that has
+// already started puts the cat on leave right away, within the same transaction.
+func (s *leaveService) ScheduleLeave(ctx context.Context, leave *domain.Leave) error {
+	if leave.Kind == "" {
+		leave.Kind = domain.LeaveKindLeave
+	}
+	if leave.Kind != domain.LeaveKindLeave && leave.Kind != domain.LeaveKindTraining {
+		return fmt.Errorf("invalid leave kind: %s", leave.Kind)
+	}
+
+	today := dateOf(time.Now())
+	leave.StartDate, leave.EndDate = dateOf(leave.StartDate), dateOf(leave.EndDate)
+	if leave.EndDate.Before(leave.StartDate) {
+		return fmt.Errorf("leave end must not be before leave start")
+	}
+	if leave.EndDate.Before(today) {
+		return fmt.Errorf("leave must not end in the past")
+	}
+
+	// The database rejects overlaps too, but listing them here names the leave in the way
+	overlapping, err := s.leaveRepo.ListLeaves(ctx, leave.CatID, leave.StartDate, leave.EndDate)
+	if err != nil {
+		return err
+	}
+	if len(overlapping) > 0 {
+		return fmt.Errorf("leave overlaps leave %d", overlapping[0].ID)
+	}
+
+	return s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.leaveRepo.CreateLeave(ctx, leave, today); err != nil {
+			return err
+		}
+		if leave.StartDate.After(today) {
+			return nil
+		}
+		_, _, err := s.leaveRepo.SyncLeaveStatuses(ctx, today, &leave.CatID)
+		return err
+	})
+}
+
+// GetAvailability retrieves the leave periods of a cat within the inclusive range
+// [from, to] and whether it can take a mission today.
+func (s *leaveService) GetAvailability(ctx context.Context, catID int, from, to time.Time) (*domain.CatAvailability, error) {
+	from, to = dateOf(from), dateOf(to)
+	if to.Before(from) {
+		return nil, fmt.Errorf("range end must not be before range start")
+	}
+
+	cat, err := s.catRepo.GetCatByID(ctx, catID)
+	if err != nil {
+		return nil, err
+	}
+	leaves, err := s.leaveRepo.ListLeaves(ctx, catID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	today := dateOf(time.Now())
+	available := cat.Status.CanTransitionTo(domain.CatStatusOnMission)
+	for _, leave := range leaves {
+		if !today.Before(dateOf(leave.StartDate)) && !today.After(dateOf(leave.EndDate)) {
+			available = false
+		}
+	}
+
+	return &domain.CatAvailability{
+		CatID:     cat.ID,
+		Status:    cat.Status,
+		From:      from,
+		To:        to,
+		Available: available,
+		Leaves:    leaves,
+	}, nil
+}
+
+// SyncStatuses puts cats whose leave has started on leave and makes cats whose leave
+// has ended available again.
+func (s *leaveService) SyncStatuses(ctx context.Context) error {
+	started, ended, err := s.leaveRepo.SyncLeaveStatuses(ctx, dateOf(time.Now()), nil)
+	if err != nil {
+		return err
+	}
+	if started > 0 || ended > 0 {
+		s.logger.Info("Cat leave statuses synced", slog.Int("started", started), slog.Int("ended", ended))
+	}
+	return nil
+}
+
+// checkCatLeave returns an error if the cat has leave scheduled within the inclusive
+// range [from, to].
+func checkCatLeave(ctx context.Context, leaveRepo repository.LeaveRepository, catID int, from, to time.Time) error {
+	leaves, err := leaveRepo.ListLeaves(ctx, catID, dateOf(from), dateOf(to))
+	if err != nil {
+		return err
+	}
+	if len(leaves) > 0 {
+		return fmt.Errorf("cat is on %s from %s to %s", leaves[0].Kind, leaves[0].StartDate.Format("2006-01-02"), leaves[0].EndDate.Format("2006-01-02"))
+	}
+	return nil
+}
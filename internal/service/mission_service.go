@@ -3,42 +3,96 @@ package service
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"math"
+	"sort"
 	"spy_cats_agency/internal/domain"
 	"spy_cats_agency/internal/repository"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // missionService is the implementation of the MissionService interface.
 type missionService struct {
 	missionRepo repository.MissionRepository
 	catRepo     repository.CatRepository
+	skillRepo   repository.SkillRepository
+	leaveRepo   repository.LeaveRepository
+	eventRepo   repository.MissionEventRepository
+	transactor  repository.Transactor
+	logger      *slog.Logger
 }
 
-// NewMissionService creates a new MissionService.
-func NewMissionService(missionRepo repository.MissionRepository, catRepo repository.CatRepository) MissionService {
+// NewMissionService creates a new MissionService. Changes are recorded in the mission
+// timeline within the transaction that makes them.
+func NewMissionService(missionRepo repository.MissionRepository, catRepo repository.CatRepository, skillRepo repository.SkillRepository, leaveRepo repository.LeaveRepository, eventRepo repository.MissionEventRepository, transactor repository.Transactor, logger *slog.Logger) MissionService {
 	return &missionService{
 		missionRepo: missionRepo,
 		catRepo:     catRepo,
+		skillRepo:   skillRepo,
+		leaveRepo:   leaveRepo,
+		eventRepo:   eventRepo,
+		transactor:  transactor,
+		logger:      logger,
 	}
 }
 
-// CreateMission creates a new mission, ensuring it has between 1 and 3 targets.
+// CreateMission creates a new mission, ensuring it has between 1 and 3 targets
+// and that an assigned cat has the skills the mission requires and isn't on leave.
+// Missions without a priority get the default one. Every mission gets a generated
+// codename and reference code.
 func (s *missionService) CreateMission(ctx context.Context, mission *domain.Mission) error {
 	if len(mission.Targets) < 1 || len(mission.Targets) > 3 {
 		return fmt.Errorf("a mission must have between 1 and 3 targets")
 	}
+	if mission.Priority == 0 {
+		mission.Priority = domain.MissionPriorityDefault
+	}
+	if mission.Priority < domain.MissionPriorityHighest || mission.Priority > domain.MissionPriorityLowest {
+		return fmt.Errorf("mission priority must be between %d and %d", domain.MissionPriorityHighest, domain.MissionPriorityLowest)
+	}
+	if mission.Deadline != nil && !mission.Deadline.After(time.Now()) {
+		return fmt.Errorf("mission deadline must be in the future")
+	}
+	if mission.Budget != nil && *mission.Budget < 0 {
+		return fmt.Errorf("mission budget must not be negative")
+	}
+	if err := resolveRequirements(ctx, s.skillRepo, mission.RequiredSkills); err != nil {
+		return err
+	}
 
-	// If a cat ID is provided, validate that the cat exists and is available
+	// If a cat ID is provided, validate that the cat exists, is available and qualified
+	mission.Status = domain.MissionStatusDraft
 	if mission.CatID != nil {
-		cat, err := s.catRepo.GetCatByID(ctx, *mission.CatID)
-		if err != nil {
-			return fmt.Errorf("cat not found: %w", err)
-		}
-		if cat.Status != "available" {
-			return fmt.Errorf("cat is not available for a mission")
+		mission.Status = domain.MissionStatusAssigned
+		if err := s.checkAssignable(ctx, *mission.CatID, mission); err != nil {
+			return err
 		}
 	}
 
-	return s.missionRepo.CreateMission(ctx, mission)
+	// Each attempt runs in its own transaction, as a taken code aborts the transaction
+	return withMissionCodes(func(codename, code string) error {
+		mission.Codename, mission.Code = codename, code
+		return s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+			if err := s.missionRepo.CreateMission(ctx, mission); err != nil {
+				return err
+			}
+			err := recordEvent(ctx, s.eventRepo, mission.ID, domain.MissionEventCreated, map[string]any{
+				"codename": mission.Codename,
+				"code":     mission.Code,
+				"priority": mission.Priority,
+				"targets":  len(mission.Targets),
+			})
+			if err != nil || mission.CatID == nil {
+				return err
+			}
+			return recordEvent(ctx, s.eventRepo, mission.ID, domain.MissionEventCatAssigned, map[string]any{
+				"cat_id": *mission.CatID,
+				"role":   domain.TeamRoleLead,
+			})
+		})
+	})
 }
 
 // GetMission retrieves a mission by its ID.
@@ -46,9 +100,73 @@ func (s *missionService) GetMission(ctx context.Context, id int) (*domain.Missio
 	return s.missionRepo.GetMissionByID(ctx, id)
 }
 
-// ListMissions retrieves all missions.
-func (s *missionService) ListMissions(ctx context.Context) ([]domain.Mission, error) {
-	return s.missionRepo.ListMissions(ctx)
+// GetMissionByCode retrieves a mission by its reference code, ignoring case.
+func (s *missionService) GetMissionByCode(ctx context.Context, code string) (*domain.Mission, error) {
+	return s.missionRepo.GetMissionByCode(ctx, strings.ToUpper(strings.TrimSpace(code)))
+}
+
+// ListMissions retrieves a page of missions matching the filter.
+func (s *missionService) ListMissions(ctx context.Context, filter domain.MissionFilter) (*domain.MissionPage, error) {
+	return s.missionRepo.ListMissions(ctx, filter)
+}
+
+// ListOverdueMissions retrieves the missions that are still active past their deadline.
+func (s *missionService) ListOverdueMissions(ctx context.Context) ([]domain.Mission, error) {
+	return s.missionRepo.ListOverdueMissions(ctx, time.Now())
+}
+
+// FlagOverdueMissions flags the missions that became overdue since the last sweep and
+// logs each of them.
+func (s *missionService) FlagOverdueMissions(ctx context.Context) error {
+	missions, err := s.missionRepo.FlagOverdueMissions(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+	for _, m := range missions {
+		attrs := []any{
+			slog.Int("mission_id", m.ID),
+			slog.String("status", string(m.Status)),
+			slog.Int("priority", m.Priority),
+			slog.Time("deadline", *m.Deadline),
+		}
+		if m.CatID != nil {
+			attrs = append(attrs, slog.Int("cat_id", *m.CatID))
+		}
+		s.logger.Warn("Mission is overdue", attrs...)
+	}
+	return nil
+}
+
+// CloneMission copies a mission, e.g. to re-run a failed or completed operation, into a
+// new unassigned draft that references the original. Target notes are reset unless
+// keepNotes is set.
+func (s *missionService) CloneMission(ctx context.Context, missionID int, keepNotes bool) (*domain.Mission, error) {
+	var id int
+	err := withMissionCodes(func(codename, code string) error {
+		return s.transactor.WithinTx(ctx, func(ctx context.Context) (err error) {
+			id, err = s.missionRepo.CloneMission(ctx, missionID, keepNotes, codename, code)
+			if err != nil {
+				return err
+			}
+			return recordEvent(ctx, s.eventRepo, id, domain.MissionEventCreated, map[string]any{
+				"codename":       codename,
+				"code":           code,
+				"cloned_from_id": missionID,
+			})
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.missionRepo.GetMissionByID(ctx, id)
+}
+
+// ListCatMissions retrieves the mission history of a cat, latest first.
+func (s *missionService) ListCatMissions(ctx context.Context, catID int) ([]domain.Mission, error) {
+	if _, err := s.catRepo.GetCatByID(ctx, catID); err != nil {
+		return nil, fmt.Errorf("cat not found: %w", err)
+	}
+	return s.missionRepo.ListMissionsByCat(ctx, catID)
 }
 
 // UpdateMission is a placeholder for more complex mission update logic if needed.
@@ -68,35 +186,425 @@ func (s *missionService) DeleteMission(ctx context.Context, id int) error {
 	return s.missionRepo.DeleteMission(ctx, id)
 }
 
-// AssignCatToMission assigns an available cat to a mission.
+// AssignCatToMission assigns an available cat with the required skills to a mission,
+// replacing the cat that was assigned before.
 func (s *missionService) AssignCatToMission(ctx context.Context, missionID, catID int) error {
+	_, err := s.ReassignCat(ctx, missionID, &catID)
+	return err
+}
+
+// ReassignCat swaps the cat assigned to a mission, or unassigns it if catID is nil.
+// The outgoing cat becomes available again and the incoming cat goes on the mission as
+// the lead of its team. Unassigning the cat releases the whole team.
+// A draft mission becomes assigned, once its prerequisites are completed, and an assigned
+// mission without a cat goes back to draft. Missions in progress keep their status, so
+// they can't be left without a cat, and missions that have ended can't be reassigned.
+func (s *missionService) ReassignCat(ctx context.Context, missionID int, catID *int) (*domain.Mission, error) {
+	mission, err := s.missionRepo.GetMissionByID(ctx, missionID)
+	if err != nil {
+		return nil, err
+	}
+	if mission.Status.IsTerminal() {
+		return nil, fmt.Errorf("cannot reassign a mission that has ended")
+	}
+	if catID == nil && mission.CatID == nil {
+		return nil, fmt.Errorf("mission has no cat assigned")
+	}
+	if catID != nil && mission.CatID != nil && *catID == *mission.CatID {
+		return nil, fmt.Errorf("cat is already assigned to this mission")
+	}
+
+	from, fromStatus := mission.CatID, mission.Status
+	switch {
+	case catID == nil && mission.Status == domain.MissionStatusInProgress:
+		return nil, fmt.Errorf("a mission in progress cannot be left without a cat, abort it instead")
+	case catID == nil:
+		mission.Status = domain.MissionStatusDraft
+	case mission.Status == domain.MissionStatusDraft:
+		if err := checkPrerequisites(ctx, s.missionRepo, missionID); err != nil {
+			return nil, err
+		}
+		mission.Status = domain.MissionStatusAssigned
+	}
+	if catID != nil {
+		if err := s.checkAssignable(ctx, *catID, mission); err != nil {
+			return nil, err
+		}
+	}
+
+	mission.CatID = catID
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.missionRepo.ReassignCat(ctx, mission, from, fromStatus); err != nil {
+			return err
+		}
+		if catID == nil {
+			return releasedTeamEvent(ctx, s.missionRepo, s.eventRepo, mission, "lead_unassigned")
+		}
+		return recordEvent(ctx, s.eventRepo, missionID, domain.MissionEventCatAssigned, map[string]any{
+			"cat_id":          *catID,
+			"role":            domain.TeamRoleLead,
+			"previous_cat_id": from,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mission, nil
+}
+
+// AddTeamMember puts a cat on a mission team. Adding a lead reassigns the mission's cat,
+// promoting it if it is already on the team, while support cats and handler liaisons
+// join a mission that already has a lead. Team members must be available and not on
+// leave until the deadline, but only the lead needs the skills the mission requires.
+func (s *missionService) AddTeamMember(ctx context.Context, missionID, catID int, role domain.TeamRole) (*domain.Mission, error) {
+	if !role.IsValid() {
+		return nil, fmt.Errorf("invalid team role: %s", role)
+	}
+	if role == domain.TeamRoleLead {
+		if _, err := s.ReassignCat(ctx, missionID, &catID); err != nil {
+			return nil, err
+		}
+		return s.missionRepo.GetMissionByID(ctx, missionID)
+	}
+
+	mission, err := s.missionRepo.GetMissionByID(ctx, missionID)
+	if err != nil {
+		return nil, err
+	}
+	if mission.Status.IsTerminal() {
+		return nil, fmt.Errorf("cannot change the team of a mission that has ended")
+	}
+	if mission.CatID == nil {
+		return nil, fmt.Errorf("mission needs a lead before other cats can join its team")
+	}
+	for _, member := range mission.Team {
+		if member.CatID == catID {
+			return nil, fmt.Errorf("cat is already on the mission team")
+		}
+	}
+
 	cat, err := s.catRepo.GetCatByID(ctx, catID)
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("cat not found: %w", err)
+	}
+	if !cat.Status.CanTransitionTo(domain.CatStatusOnMission) {
+		return nil, fmt.Errorf("cat is not available for a mission")
+	}
+	if err := checkCatLeave(ctx, s.leaveRepo, catID, time.Now(), missionEnd(mission)); err != nil {
+		return nil, err
+	}
+
+	member := &domain.TeamMember{MissionID: missionID, CatID: catID, Role: role}
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.missionRepo.AddTeamMember(ctx, member); err != nil {
+			return err
+		}
+		return recordEvent(ctx, s.eventRepo, missionID, domain.MissionEventCatAssigned, map[string]any{
+			"cat_id": catID,
+			"role":   role,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.missionRepo.GetMissionByID(ctx, missionID)
+}
+
+// RemoveTeamMember takes a cat off a mission team and makes it available again. Removing
+// the lead unassigns the mission's cat, which releases the whole team.
+func (s *missionService) RemoveTeamMember(ctx context.Context, missionID, catID int) (*domain.Mission, error) {
+	mission, err := s.missionRepo.GetMissionByID(ctx, missionID)
+	if err != nil {
+		return nil, err
+	}
+	if mission.Status.IsTerminal() {
+		return nil, fmt.Errorf("cannot change the team of a mission that has ended")
+	}
+	if mission.CatID != nil && *mission.CatID == catID {
+		if _, err := s.ReassignCat(ctx, missionID, nil); err != nil {
+			return nil, err
+		}
+		return s.missionRepo.GetMissionByID(ctx, missionID)
+	}
+
+	var role domain.TeamRole
+	for _, member := range mission.Team {
+		if member.CatID == catID {
+			role = member.Role
+		}
+	}
+
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.missionRepo.RemoveTeamMember(ctx, missionID, catID); err != nil {
+			return err
+		}
+		return recordEvent(ctx, s.eventRepo, missionID, domain.MissionEventCatUnassigned, map[string]any{
+			"cat_id": catID,
+			"role":   role,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.missionRepo.GetMissionByID(ctx, missionID)
+}
+
+// checkAssignable returns an error unless the cat can be put on the mission: it must be
+// available, or already on the mission's team, have the skills the mission requires and
+// not be on leave between today and the mission's deadline.
+func (s *missionService) checkAssignable(ctx context.Context, catID int, mission *domain.Mission) error {
+	cat, err := s.catRepo.GetCatByID(ctx, catID)
+	if err != nil {
+		return fmt.Errorf("cat not found: %w", err)
 	}
-	if cat.Status != "available" {
+	onTeam := false
+	for _, member := range mission.Team {
+		onTeam = onTeam || member.CatID == catID
+	}
+	if !onTeam && !cat.Status.CanTransitionTo(domain.CatStatusOnMission) {
 		return fmt.Errorf("cat is not available for a mission")
 	}
-	return s.missionRepo.AssignCatToMission(ctx, missionID, catID)
+	if err := checkCatSkills(ctx, s.skillRepo, catID, mission.RequiredSkills); err != nil {
+		return err
+	}
+	return checkCatLeave(ctx, s.leaveRepo, catID, time.Now(), missionEnd(mission))
 }
 
+// AddDependency makes a mission wait for a prerequisite mission to be completed before it
+// can be assigned or started. Missions that have started can't get new prerequisites,
+// and links that would make missions wait for each other are rejected.
+func (s *missionService) AddDependency(ctx context.Context, missionID, prerequisiteID int) (*domain.MissionDependencyGraph, error) {
+	if missionID == prerequisiteID {
+		return nil, fmt.Errorf("a mission cannot depend on itself")
+	}
+	if _, err := s.missionRepo.GetMissionByID(ctx, missionID); err != nil {
+		return nil, err
+	}
+	if _, err := s.missionRepo.GetMissionByID(ctx, prerequisiteID); err != nil {
+		return nil, fmt.Errorf("prerequisite mission not found: %w", err)
+	}
+
+	// The repository checks that the mission hasn't started while holding its row lock
+	dependency := domain.MissionDependency{MissionID: missionID, PrerequisiteID: prerequisiteID}
+	if err := s.missionRepo.AddDependency(ctx, dependency); err != nil {
+		return nil, err
+	}
+	return s.missionRepo.GetDependencyGraph(ctx, missionID)
+}
 
+// RemoveDependency removes a prerequisite from a mission.
+func (s *missionService) RemoveDependency(ctx context.Context, missionID, prerequisiteID int) (*domain.MissionDependencyGraph, error) {
+	dependency := domain.MissionDependency{MissionID: missionID, PrerequisiteID: prerequisiteID}
+	if err := s.missionRepo.RemoveDependency(ctx, dependency); err != nil {
+		return nil, err
+	}
+	return s.missionRepo.GetDependencyGraph(ctx, missionID)
+}
 
+// GetDependencies retrieves the missions a mission waits for and the missions waiting
+// for it, transitively.
+func (s *missionService) GetDependencies(ctx context.Context, missionID int) (*domain.MissionDependencyGraph, error) {
+	if _, err := s.missionRepo.GetMissionByID(ctx, missionID); err != nil {
+		return nil, err
+	}
+	return s.missionRepo.GetDependencyGraph(ctx, missionID)
+}
 
+// checkPrerequisites returns an error unless every prerequisite of the mission has been
+// completed.
+func checkPrerequisites(ctx context.Context, missionRepo repository.MissionRepository, missionID int) error {
+	unmet, err := missionRepo.ListUnmetPrerequisites(ctx, missionID)
+	if err != nil {
+		return err
+	}
+	if len(unmet) > 0 {
+		ids := make([]string, len(unmet))
+		for i, id := range unmet {
+			ids[i] = strconv.Itoa(id)
+		}
+		return fmt.Errorf("mission is waiting for prerequisite missions to be completed: %s", strings.Join(ids, ", "))
+	}
+	return nil
+}
 
-// CompleteMission manually marks a mission as completed or uncompleted.
-func (s *missionService) CompleteMission(ctx context.Context, missionID int, completed bool) (*domain.Mission, error) {
-	// Get the current mission
+// missionEnd is the time until which cats on a mission are expected to be busy: its
+// deadline, or now if it has none or the deadline has passed.
+func missionEnd(mission *domain.Mission) time.Time {
+	if mission.Deadline != nil && mission.Deadline.After(time.Now()) {
+		return *mission.Deadline
+	}
+	return time.Now()
+}
+
+// SetRequiredSkills replaces the skills a mission requires. If a cat is already
+// assigned, it must meet the new requirements.
+func (s *missionService) SetRequiredSkills(ctx context.Context, missionID int, requirements []domain.SkillRequirement) (*domain.Mission, error) {
 	mission, err := s.missionRepo.GetMissionByID(ctx, missionID)
 	if err != nil {
 		return nil, err
 	}
+	if mission.Status.IsTerminal() {
+		return nil, fmt.Errorf("cannot change the requirements of a mission that has ended")
+	}
+	if err := resolveRequirements(ctx, s.skillRepo, requirements); err != nil {
+		return nil, err
+	}
+	if mission.CatID != nil {
+		if err := checkCatSkills(ctx, s.skillRepo, *mission.CatID, requirements); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.missionRepo.SetRequiredSkills(ctx, missionID, requirements); err != nil {
+		return nil, err
+	}
+	return s.missionRepo.GetMissionByID(ctx, missionID)
+}
+
+// Weights of the candidate score. Experience and local knowledge are capped so that a
+// single factor can't outweigh everything else.
+const (
+	experiencePointsPerYear   = 2.0
+	maxExperienceScore        = 20.0
+	countryPointsPerTarget    = 5.0
+	maxCountryScore           = 30.0
+	workloadPenaltyPerMission = 10.0
+	workloadWindow            = 30 * 24 * time.Hour
+)
+
+// ListCandidates ranks the available cats that meet a mission's skill requirements.
+// The score rewards years of experience and targets already completed in the countries
+// of the mission's targets, and penalizes cats with active or recently completed missions.
+func (s *missionService) ListCandidates(ctx context.Context, missionID, limit int) ([]domain.MissionCandidate, error) {
+	mission, err := s.missionRepo.GetMissionByID(ctx, missionID)
+	if err != nil {
+		return nil, err
+	}
+	if mission.Status.IsTerminal() {
+		return nil, fmt.Errorf("mission has already ended")
+	}
+
+	cats, err := listAllCats(ctx, s.catRepo, domain.CatFilter{Status: domain.CatStatusAvailable})
+	if err != nil {
+		return nil, err
+	}
+	if len(cats) == 0 {
+		return []domain.MissionCandidate{}, nil
+	}
+
+	catIDs := make([]int, len(cats))
+	for i, cat := range cats {
+		catIDs[i] = cat.ID
+	}
+	catSkills, err := s.skillRepo.ListSkillsOfCats(ctx, catIDs)
+	if err != nil {
+		return nil, err
+	}
 
-	// Update the completion status
-	mission.Completed = completed
-	if err := s.missionRepo.UpdateMission(ctx, mission); err != nil {
+	var countries []string
+	seen := make(map[string]bool)
+	for _, t := range mission.Targets {
+		if key := strings.ToLower(t.Country); !seen[key] {
+			seen[key] = true
+			countries = append(countries, t.Country)
+		}
+	}
+	localTargets, err := s.missionRepo.CountCompletedTargetsByCat(ctx, countries)
+	if err != nil {
+		return nil, err
+	}
+	workload, err := s.missionRepo.CountRecentMissionsByCat(ctx, time.Now().Add(-workloadWindow))
+	if err != nil {
 		return nil, err
 	}
 
+	candidates := []domain.MissionCandidate{}
+	for _, cat := range cats {
+		if len(missingSkills(catSkills[cat.ID], mission.RequiredSkills)) > 0 {
+			continue
+		}
+
+		c := domain.MissionCandidate{
+			Cat:             cat,
+			ExperienceScore: math.Min(float64(cat.YearsOfExperience)*experiencePointsPerYear, maxExperienceScore),
+			CountryScore:    math.Min(float64(localTargets[cat.ID])*countryPointsPerTarget, maxCountryScore),
+			WorkloadPenalty: float64(workload[cat.ID]) * workloadPenaltyPerMission,
+		}
+		c.Score = c.ExperienceScore + c.CountryScore - c.WorkloadPenalty
+		c.Explanation = []string{
+			fmt.Sprintf("%d years of experience: +%.0f", cat.YearsOfExperience, c.ExperienceScore),
+			fmt.Sprintf("%d completed targets in %s: +%.0f", localTargets[cat.ID], strings.Join(countries, ", "), c.CountryScore),
+			fmt.Sprintf("%d active or recent missions: -%.0f", workload[cat.ID], c.WorkloadPenalty),
+		}
+		if len(mission.RequiredSkills) > 0 {
+			c.Explanation = append(c.Explanation, "meets all required skills")
+		}
+		candidates = append(candidates, c)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score > candidates[j].Score
+		}
+		return candidates[i].Cat.ID < candidates[j].Cat.ID
+	})
+	if limit > 0 && len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+	return candidates, nil
+}
+
+// CompleteMission manually marks a mission in progress as completed. Completed missions
+// have ended and can't be marked as uncompleted again.
+func (s *missionService) CompleteMission(ctx context.Context, missionID int, completed bool) (*domain.Mission, error) {
+	if !completed {
+		return nil, fmt.Errorf("completed missions cannot be reopened")
+	}
+	return s.ChangeMissionStatus(ctx, missionID, domain.MissionStatusCompleted)
+}
+
+// ChangeMissionStatus moves a mission to a new status according to the status transition
+// rules. Assignment owns the draft and assigned statuses, so missions can't be assigned
+// or unassigned this way.
+func (s *missionService) ChangeMissionStatus(ctx context.Context, missionID int, status domain.MissionStatus) (*domain.Mission, error) {
+	if !status.IsValid() {
+		return nil, fmt.Errorf("invalid mission status: %s", status)
+	}
+
+	mission, err := s.missionRepo.GetMissionByID(ctx, missionID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case status == domain.MissionStatusDraft || status == domain.MissionStatusAssigned:
+		return nil, fmt.Errorf("missions are assigned and unassigned through their cat")
+	case !mission.Status.CanTransitionTo(status):
+		return nil, fmt.Errorf("cannot change mission status from %s to %s", mission.Status, status)
+	case status == domain.MissionStatusInProgress:
+		if err := checkPrerequisites(ctx, s.missionRepo, missionID); err != nil {
+			return nil, err
+		}
+	}
+
+	from := mission.Status
+	mission.Status = status
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.missionRepo.UpdateMissionStatus(ctx, mission, from); err != nil {
+			return err
+		}
+		return statusEvent(ctx, s.missionRepo, s.eventRepo, mission, from)
+	})
+	if err != nil {
+		return nil, err
+	}
 	return mission, nil
 }
+
+// GetTimeline retrieves the events of a mission in the order they happened.
+func (s *missionService) GetTimeline(ctx context.Context, missionID int) ([]domain.MissionEvent, error) {
+	if _, err := s.missionRepo.GetMissionByID(ctx, missionID); err != nil {
+		return nil, err
+	}
+	return s.eventRepo.ListEvents(ctx, missionID)
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"spy_cats_agency/internal/domain"
+	"spy_cats_agency/internal/repository"
+	"strings"
+)
+
+// missionTemplateService is the implementation of the MissionTemplateService interface.
+type missionTemplateService struct {
+	templateRepo   repository.MissionTemplateRepository
+	missionService MissionService
+}
+
+// NewMissionTemplateService creates a new MissionTemplateService. Missions are created
+// through missionService, so they are validated like any other mission.
+func NewMissionTemplateService(templateRepo repository.MissionTemplateRepository, missionService MissionService) MissionTemplateService {
+	return &missionTemplateService{
+		templateRepo:   templateRepo,
+		missionService: missionService,
+	}
+}
+
+// CreateTemplate creates a new template, ensuring it has between 1 and 3 targets like a mission.
+func (s *missionTemplateService) CreateTemplate(ctx context.Context, template *domain.MissionTemplate) error {
+	if err := validateTemplate(template); err != nil {
+		return err
+	}
+	return s.templateRepo.CreateTemplate(ctx, template)
+}
+
+// GetTemplate retrieves a template with its targets.
+func (s *missionTemplateService) GetTemplate(ctx context.Context, id int) (*domain.MissionTemplate, error) {
+	return s.templateRepo.GetTemplateByID(ctx, id)
+}
+
+// ListTemplates retrieves all templates without their targets.
+func (s *missionTemplateService) ListTemplates(ctx context.Context) ([]domain.MissionTemplate, error) {
+	return s.templateRepo.ListTemplates(ctx)
+}
+
+// UpdateTemplate replaces a template's name, description and targets.
+func (s *missionTemplateService) UpdateTemplate(ctx context.Context, template *domain.MissionTemplate) error {
+	if err := validateTemplate(template); err != nil {
+		return err
+	}
+	return s.templateRepo.UpdateTemplate(ctx, template)
+}
+
+// DeleteTemplate deletes a template. Missions created from it are kept.
+func (s *missionTemplateService) DeleteTemplate(ctx context.Context, id int) error {
+	return s.templateRepo.DeleteTemplate(ctx, id)
+}
+
+// CreateMissionFromTemplate creates a mission with the targets of a template, applying
+// the overrides on top.
+func (s *missionTemplateService) CreateMissionFromTemplate(ctx context.Context, templateID int, overrides domain.MissionOverrides) (*domain.Mission, error) {
+	template, err := s.templateRepo.GetTemplateByID(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	mission := &domain.Mission{
+		CatID:          overrides.CatID,
+		Priority:       overrides.Priority,
+		Deadline:       overrides.Deadline,
+		RequiredSkills: overrides.RequiredSkills,
+	}
+	for _, t := range template.Targets {
+		mission.Targets = append(mission.Targets, domain.Target{Name: t.Name, Country: t.Country, Notes: t.Notes})
+	}
+
+	for _, o := range overrides.Targets {
+		if o.Position < 1 || o.Position > len(mission.Targets) {
+			return nil, fmt.Errorf("template has no target at position %d", o.Position)
+		}
+		target := &mission.Targets[o.Position-1]
+		if o.Name != nil {
+			target.Name = *o.Name
+		}
+		if o.Country != nil {
+			target.Country = *o.Country
+		}
+		if o.Notes != nil {
+			target.Notes = *o.Notes
+		}
+	}
+
+	if err := s.missionService.CreateMission(ctx, mission); err != nil {
+		return nil, err
+	}
+	return mission, nil
+}
+
+func validateTemplate(template *domain.MissionTemplate) error {
+	if strings.TrimSpace(template.Name) == "" {
+		return fmt.Errorf("template name must not be empty")
+	}
+	if len(template.Targets) < 1 || len(template.Targets) > 3 {
+		return fmt.Errorf("a mission template must have between 1 and 3 targets")
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"spy_cats_agency/internal/domain"
+	"spy_cats_agency/internal/repository"
+	"time"
+)
+
+// maxPayrollPeriodDays is the longest period a payroll run can cover, a leap year.
+const maxPayrollPeriodDays = 366
+
+// payrollService is the implementation of the PayrollService interface.
+type payrollService struct {
+	payrollRepo  repository.PayrollRepository
+	catRepo      repository.CatRepository
+	missionRepo  repository.MissionRepository
+	missionBonus float64
+}
+
+// NewPayrollService creates a new PayrollService. missionBonus is the default bonus
+// paid per completed mission when a run doesn't specify one.
+func NewPayrollService(payrollRepo repository.PayrollRepository, catRepo repository.CatRepository, missionRepo repository.MissionRepository, missionBonus float64) PayrollService {
+	return &payrollService{
+		payrollRepo:  payrollRepo,
+		catRepo:      catRepo,
+		missionRepo:  missionRepo,
+		missionBonus: missionBonus,
+	}
+}
+
+// CreatePayrollRun calculates the pay of every cat for the inclusive period between
+// periodStart and periodEnd and stores it as a new payroll run. Salaries are monthly
+// and prorated per day from the salary history, so hires, retirements, restores and
+// raises in the middle of the period are paid exactly, and days a cat spent retired are
+// not paid. Periods of existing runs can't be paid twice; the overlap check here names
+// the run, the database enforces it for concurrent requests.
+func (s *payrollService) CreatePayrollRun(ctx context.Context, periodStart, periodEnd time.Time, missionBonus *float64) (*domain.PayrollRun, error) {
+	periodStart, periodEnd = dateOf(periodStart), dateOf(periodEnd)
+	if periodEnd.Before(periodStart) {
+		return nil, fmt.Errorf("period end must not be before period start")
+	}
+	if periodEnd.Sub(periodStart) >= maxPayrollPeriodDays*24*time.Hour {
+		return nil, fmt.Errorf("period must not be longer than %d days", maxPayrollPeriodDays)
+	}
+
+	bonus := s.missionBonus
+	if missionBonus != nil {
+		bonus = *missionBonus
+	}
+	if bonus < 0 {
+		return nil, fmt.Errorf("mission bonus must not be negative")
+	}
+
+	runs, err := s.payrollRepo.ListPayrollRuns(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, run := range runs {
+		if !periodStart.After(dateOf(run.PeriodEnd)) && !periodEnd.Before(dateOf(run.PeriodStart)) {
+			return nil, fmt.Errorf("period overlaps payroll run %d", run.ID)
+		}
+	}
+
+	cats, err := listAllCats(ctx, s.catRepo, domain.CatFilter{IncludeArchived: true})
+	if err != nil {
+		return nil, err
+	}
+	changes, err := s.catRepo.ListSalaryChangesUntil(ctx, periodEnd)
+	if err != nil {
+		return nil, err
+	}
+	retirements, err := s.catRepo.ListRetirementsUntil(ctx, periodEnd)
+	if err != nil {
+		return nil, err
+	}
+	completed, err := s.missionRepo.CountCompletedMissionsByCat(ctx, periodStart, periodEnd.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+
+	changesByCat := make(map[int][]domain.SalaryChange)
+	for _, change := range changes {
+		changesByCat[change.CatID] = append(changesByCat[change.CatID], change)
+	}
+	retirementsByCat := make(map[int][]domain.CatRetirement)
+	for _, retirement := range retirements {
+		retirementsByCat[retirement.CatID] = append(retirementsByCat[retirement.CatID], retirement)
+	}
+
+	run := &domain.PayrollRun{
+		PeriodStart:  periodStart,
+		PeriodEnd:    periodEnd,
+		MissionBonus: bonus,
+	}
+	for _, cat := range cats {
+		days, basePay := 0, 0.0
+		for _, paid := range paidRanges(periodStart, periodEnd, retirementsByCat[cat.ID]) {
+			d, pay := proratedPay(changesByCat[cat.ID], paid[0], paid[1])
+			days, basePay = days+d, basePay+pay
+		}
+		missions := completed[cat.ID]
+		if days == 0 && missions == 0 {
+			continue
+		}
+
+		entry := domain.PayrollEntry{
+			CatID:             cat.ID,
+			CatName:           cat.Name,
+			DaysWorked:        days,
+			BasePay:           roundCents(basePay),
+			MissionsCompleted: missions,
+			Bonus:             roundCents(float64(missions) * bonus),
+		}
+		entry.Total = roundCents(entry.BasePay + entry.Bonus)
+		run.Total = roundCents(run.Total + entry.Total)
+		run.Entries = append(run.Entries, entry)
+	}
+
+	if err := s.payrollRepo.CreatePayrollRun(ctx, run); err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// GetPayrollRun retrieves a payroll run with its entries.
+func (s *payrollService) GetPayrollRun(ctx context.Context, id int) (*domain.PayrollRun, error) {
+	return s.payrollRepo.GetPayrollRunByID(ctx, id)
+}
+
+// ListPayrollRuns retrieves all payroll runs without their entries.
+func (s *payrollService) ListPayrollRuns(ctx context.Context) ([]domain.PayrollRun, error) {
+	return s.payrollRepo.ListPayrollRuns(ctx)
+}
+
+// paidRanges splits the inclusive period between from and to into the inclusive ranges
+// a cat was in service, given its retirements sorted by time. Cats are paid up to and
+// including their retirement day, and again from the day they are restored.
+func paidRanges(from, to time.Time, retirements []domain.CatRetirement) [][2]time.Time {
+	var ranges [][2]time.Time
+	start := from
+	for _, retirement := range retirements {
+		retired := dateOf(retirement.RetiredAt)
+		if retired.After(to) {
+			break
+		}
+		if !retired.Before(start) {
+			ranges = append(ranges, [2]time.Time{start, retired})
+			start = retired.AddDate(0, 0, 1)
+		}
+		if retirement.RestoredAt == nil {
+			return ranges
+		}
+		if restored := dateOf(*retirement.RestoredAt); restored.After(start) {
+			start = restored
+		}
+	}
+	if !start.After(to) {
+		ranges = append(ranges, [2]time.Time{start, to})
+	}
+	return ranges
+}
+
+// proratedPay calculates how many days a cat was paid for between from and to (both
+// inclusive) and how much it earned, given its salary history sorted by effective date.
+// Each day is paid at the monthly salary in effect on that day divided by the number of
+// days in that month. Days before the first entry, the starting salary, are not paid.
+func proratedPay(changes []domain.SalaryChange, from, to time.Time) (int, float64) {
+	days, pay := 0, 0.0
+	current := -1
+	for day := dateOf(from); !day.After(to); day = day.AddDate(0, 0, 1) {
+		for current+1 < len(changes) && !dateOf(changes[current+1].EffectiveDate).After(day) {
+			current++
+		}
+		if current < 0 {
+			continue
+		}
+		days++
+		pay += changes[current].NewSalary / float64(daysInMonth(day))
+	}
+	return days, pay
+}
+
+// dateOf strips the time of day, keeping the calendar date.
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func daysInMonth(t time.Time) int {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func roundCents(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
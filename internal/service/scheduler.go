@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// RunPeriodically runs job right away and then every interval until ctx is cancelled.
+// Errors are logged and don't stop the schedule.
+func RunPeriodically(ctx context.Context, logger *slog.Logger, name string, interval time.Duration, job func(ctx context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := job(ctx); err != nil {
+			logger.Error("Background job failed", slog.String("job", name), slog.Any("error", err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -3,28 +3,88 @@ package service
 import (
 	"context"
 	"spy_cats_agency/internal/domain"
+	"time"
 )
 
 // CatService defines the interface for cat-related business logic.
 type CatService interface {
 	CreateCat(ctx context.Context, cat *domain.Cat) error
+	ImportCats(ctx context.Context, rows []domain.CatImportRow, mode domain.ImportMode) (*domain.CatImportReport, error)
 	GetCat(ctx context.Context, id int) (*domain.Cat, error)
-	ListCats(ctx context.Context) ([]domain.Cat, error)
-	UpdateCatSalary(ctx context.Context, id int, salary float64) (*domain.Cat, error)
-	DeleteCat(ctx context.Context, id int) error
+	GetCatDossier(ctx context.Context, id int) (*domain.CatDossier, error)
+	GetCatStats(ctx context.Context, id int) (*domain.CatStats, error)
+	GetLeaderboard(ctx context.Context, sortBy string, limit int) ([]domain.CatStats, error)
+	ListCats(ctx context.Context, filter domain.CatFilter) (*domain.CatPage, error)
+	UpdateCat(ctx context.Context, id int, update domain.CatUpdate) (*domain.Cat, error)
+	UpdateCatSalary(ctx context.Context, id int, change domain.SalaryChange) (*domain.Cat, error)
+	GetSalaryHistory(ctx context.Context, id int) ([]domain.SalaryChange, error)
+	ChangeCatStatus(ctx context.Context, id int, status domain.CatStatus) (*domain.Cat, error)
+	RetireCat(ctx context.Context, id int, reason string) error
+	RestoreCat(ctx context.Context, id int) (*domain.Cat, error)
+}
+
+// LeaveService defines the interface for cat leave and availability.
+type LeaveService interface {
+	ScheduleLeave(ctx context.Context, leave *domain.Leave) error
+	GetAvailability(ctx context.Context, catID int, from, to time.Time) (*domain.CatAvailability, error)
+
+	// SyncStatuses moves cats on and off leave as their leave periods start and end.
+	SyncStatuses(ctx context.Context) error
+}
+
+// ExpenseService defines the interface for mission budgets and expenses.
+type ExpenseService interface {
+	SetBudget(ctx context.Context, missionID int, budget *float64) (*domain.Mission, error)
+	RecordExpense(ctx context.Context, expense *domain.Expense, override bool) error
+	ListExpenses(ctx context.Context, missionID int) ([]domain.Expense, error)
+	GetFinance(ctx context.Context, missionID int) (*domain.MissionFinance, error)
+}
+
+// BriefingService defines the interface for mission briefing documents.
+type BriefingService interface {
+	RenderBriefing(ctx context.Context, missionID int, format string) ([]byte, error)
 }
 
 // MissionService defines the interface for mission-related business logic.
 type MissionService interface {
 	CreateMission(ctx context.Context, mission *domain.Mission) error
 	GetMission(ctx context.Context, id int) (*domain.Mission, error)
-	ListMissions(ctx context.Context) ([]domain.Mission, error)
+	GetMissionByCode(ctx context.Context, code string) (*domain.Mission, error)
+	CloneMission(ctx context.Context, missionID int, keepNotes bool) (*domain.Mission, error)
+	ListMissions(ctx context.Context, filter domain.MissionFilter) (*domain.MissionPage, error)
+	ListOverdueMissions(ctx context.Context) ([]domain.Mission, error)
+
+	// FlagOverdueMissions flags and logs the missions that have passed their deadline.
+	FlagOverdueMissions(ctx context.Context) error
+	ListCatMissions(ctx context.Context, catID int) ([]domain.Mission, error)
 	UpdateMission(ctx context.Context, mission *domain.Mission) error
 	DeleteMission(ctx context.Context, id int) error
 	AssignCatToMission(ctx context.Context, missionID, catID int) error
+	ReassignCat(ctx context.Context, missionID int, catID *int) (*domain.Mission, error)
+	AddTeamMember(ctx context.Context, missionID, catID int, role domain.TeamRole) (*domain.Mission, error)
+	RemoveTeamMember(ctx context.Context, missionID, catID int) (*domain.Mission, error)
 
-	// CompleteMission manually marks a mission as completed or uncompleted.
+	AddDependency(ctx context.Context, missionID, prerequisiteID int) (*domain.MissionDependencyGraph, error)
+	RemoveDependency(ctx context.Context, missionID, prerequisiteID int) (*domain.MissionDependencyGraph, error)
+	GetDependencies(ctx context.Context, missionID int) (*domain.MissionDependencyGraph, error)
+
+	SetRequiredSkills(ctx context.Context, missionID int, requirements []domain.SkillRequirement) (*domain.Mission, error)
+	ListCandidates(ctx context.Context, missionID, limit int) ([]domain.MissionCandidate, error)
+
+	// CompleteMission manually marks a mission as completed.
 	CompleteMission(ctx context.Context, missionID int, completed bool) (*domain.Mission, error)
+	ChangeMissionStatus(ctx context.Context, missionID int, status domain.MissionStatus) (*domain.Mission, error)
+	GetTimeline(ctx context.Context, missionID int) ([]domain.MissionEvent, error)
+}
+
+// MissionTemplateService defines the interface for mission templates.
+type MissionTemplateService interface {
+	CreateTemplate(ctx context.Context, template *domain.MissionTemplate) error
+	GetTemplate(ctx context.Context, id int) (*domain.MissionTemplate, error)
+	ListTemplates(ctx context.Context) ([]domain.MissionTemplate, error)
+	UpdateTemplate(ctx context.Context, template *domain.MissionTemplate) error
+	DeleteTemplate(ctx context.Context, id int) error
+	CreateMissionFromTemplate(ctx context.Context, templateID int, overrides domain.MissionOverrides) (*domain.Mission, error)
 }
 
 // TargetService defines the interface for target-related business logic.
@@ -34,3 +94,19 @@ type TargetService interface {
 	CompleteTarget(ctx context.Context, targetID int) (*domain.Target, error)
 	DeleteTarget(ctx context.Context, targetID int) error
 }
+
+// SkillService defines the interface for the skill catalog and cat skills.
+type SkillService interface {
+	CreateSkill(ctx context.Context, skill *domain.Skill) error
+	ListSkills(ctx context.Context) ([]domain.Skill, error)
+	ListCatSkills(ctx context.Context, catID int) ([]domain.CatSkill, error)
+	SetCatSkill(ctx context.Context, catID, skillID, proficiency int) ([]domain.CatSkill, error)
+	RemoveCatSkill(ctx context.Context, catID, skillID int) error
+}
+
+// PayrollService defines the interface for payroll calculation.
+type PayrollService interface {
+	CreatePayrollRun(ctx context.Context, periodStart, periodEnd time.Time, missionBonus *float64) (*domain.PayrollRun, error)
+	GetPayrollRun(ctx context.Context, id int) (*domain.PayrollRun, error)
+	ListPayrollRuns(ctx context.Context) ([]domain.PayrollRun, error)
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"spy_cats_agency/internal/domain"
+	"spy_cats_agency/internal/repository"
+	"strings"
+)
+
+// MissingSkillsError is returned when a cat doesn't meet the skill requirements of a mission.
+type MissingSkillsError struct {
+	Missing []domain.SkillRequirement
+}
+
+// Error implements the error interface
+func (e *MissingSkillsError) Error() string {
+	missing := make([]string, len(e.Missing))
+	for i, req := range e.Missing {
+		missing[i] = fmt.Sprintf("%s (proficiency %d)", req.Name, req.MinProficiency)
+	}
+	return "cat does not meet the mission requirements, missing skills: " + strings.Join(missing, ", ")
+}
+
+// skillService is the implementation of the SkillService interface.
+type skillService struct {
+	skillRepo repository.SkillRepository
+	catRepo   repository.CatRepository
+}
+
+// NewSkillService creates a new SkillService.
+func NewSkillService(skillRepo repository.SkillRepository, catRepo repository.CatRepository) SkillService {
+	return &skillService{
+		skillRepo: skillRepo,
+		catRepo:   catRepo,
+	}
+}
+
+// CreateSkill adds a skill to the catalog.
+func (s *skillService) CreateSkill(ctx context.Context, skill *domain.Skill) error {
+	skill.Name = strings.ToLower(strings.TrimSpace(skill.Name))
+	if skill.Name == "" {
+		return fmt.Errorf("skill name must not be empty")
+	}
+	return s.skillRepo.CreateSkill(ctx, skill)
+}
+
+// ListSkills retrieves the skill catalog.
+func (s *skillService) ListSkills(ctx context.Context) ([]domain.Skill, error) {
+	return s.skillRepo.ListSkills(ctx)
+}
+
+// ListCatSkills retrieves the skills of a cat.
+func (s *skillService) ListCatSkills(ctx context.Context, catID int) ([]domain.CatSkill, error) {
+	if _, err := s.catRepo.GetCatByID(ctx, catID); err != nil {
+		return nil, err
+	}
+	return s.skillRepo.ListCatSkills(ctx, catID)
+}
+
+// SetCatSkill gives a cat a catalog skill at the given proficiency and returns the cat's skills.
+func (s *skillService) SetCatSkill(ctx context.Context, catID, skillID, proficiency int) ([]domain.CatSkill, error) {
+	if proficiency < domain.ProficiencyNovice || proficiency > domain.ProficiencyMaster {
+		return nil, fmt.Errorf("proficiency must be between %d and %d", domain.ProficiencyNovice, domain.ProficiencyMaster)
+	}
+	if _, err := s.catRepo.GetCatByID(ctx, catID); err != nil {
+		return nil, err
+	}
+	if _, err := findSkills(ctx, s.skillRepo, []int{skillID}); err != nil {
+		return nil, err
+	}
+
+	if err := s.skillRepo.SetCatSkill(ctx, catID, skillID, proficiency); err != nil {
+		return nil, err
+	}
+	return s.skillRepo.ListCatSkills(ctx, catID)
+}
+
+// RemoveCatSkill takes a skill away from a cat.
+func (s *skillService) RemoveCatSkill(ctx context.Context, catID, skillID int) error {
+	return s.skillRepo.RemoveCatSkill(ctx, catID, skillID)
+}
+
+// findSkills looks up catalog skills by ID and fails if any of them doesn't exist.
+func findSkills(ctx context.Context, skillRepo repository.SkillRepository, ids []int) (map[int]domain.Skill, error) {
+	catalog, err := skillRepo.ListSkills(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[int]domain.Skill, len(catalog))
+	for _, skill := range catalog {
+		byID[skill.ID] = skill
+	}
+
+	found := make(map[int]domain.Skill, len(ids))
+	for _, id := range ids {
+		skill, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("skill %d not found", id)
+		}
+		found[id] = skill
+	}
+	return found, nil
+}
+
+// resolveRequirements validates mission skill requirements and fills in the skill names.
+func resolveRequirements(ctx context.Context, skillRepo repository.SkillRepository, requirements []domain.SkillRequirement) error {
+	if len(requirements) == 0 {
+		return nil
+	}
+
+	ids := make([]int, len(requirements))
+	seen := make(map[int]bool, len(requirements))
+	for i, req := range requirements {
+		if seen[req.SkillID] {
+			return fmt.Errorf("skill %d is required more than once", req.SkillID)
+		}
+		if req.MinProficiency < domain.ProficiencyNovice || req.MinProficiency > domain.ProficiencyMaster {
+			return fmt.Errorf("minimum proficiency must be between %d and %d", domain.ProficiencyNovice, domain.ProficiencyMaster)
+		}
+		seen[req.SkillID] = true
+		ids[i] = req.SkillID
+	}
+
+	skills, err := findSkills(ctx, skillRepo, ids)
+	if err != nil {
+		return err
+	}
+	for i := range requirements {
+		requirements[i].Name = skills[requirements[i].SkillID].Name
+	}
+	return nil
+}
+
+// checkCatSkills returns a MissingSkillsError if the cat lacks any of the required
+// skills or has them at a lower proficiency.
+func checkCatSkills(ctx context.Context, skillRepo repository.SkillRepository, catID int, requirements []domain.SkillRequirement) error {
+	if len(requirements) == 0 {
+		return nil
+	}
+
+	catSkills, err := skillRepo.ListCatSkills(ctx, catID)
+	if err != nil {
+		return err
+	}
+	if missing := missingSkills(catSkills, requirements); len(missing) > 0 {
+		return &MissingSkillsError{Missing: missing}
+	}
+	return nil
+}
+
+// missingSkills returns the requirements that the given cat skills don't satisfy.
+func missingSkills(catSkills []domain.CatSkill, requirements []domain.SkillRequirement) []domain.SkillRequirement {
+	proficiency := make(map[int]int, len(catSkills))
+	for _, skill := range catSkills {
+		proficiency[skill.SkillID] = skill.Proficiency
+	}
+
+	var missing []domain.SkillRequirement
+	for _, req := range requirements {
+		if proficiency[req.SkillID] < req.MinProficiency {
+			missing = append(missing, req)
+		}
+	}
+	return missing
+}
@@ -11,33 +11,46 @@ import (
 type targetService struct {
 	targetRepo  repository.TargetRepository
 	missionRepo repository.MissionRepository
+	eventRepo   repository.MissionEventRepository
+	transactor  repository.Transactor
 }
 
 // NewTargetService creates a new TargetService.
-func NewTargetService(targetRepo repository.TargetRepository, missionRepo repository.MissionRepository) TargetService {
+func NewTargetService(targetRepo repository.TargetRepository, missionRepo repository.MissionRepository, eventRepo repository.MissionEventRepository, transactor repository.Transactor) TargetService {
 	return &targetService{
 		targetRepo:  targetRepo,
 		missionRepo: missionRepo,
+		eventRepo:   eventRepo,
+		transactor:  transactor,
 	}
 }
 
-// AddTargetToMission adds a target to an existing, non-completed mission.
+// AddTargetToMission adds a target to an existing mission that hasn't ended.
 func (s *targetService) AddTargetToMission(ctx context.Context, missionID int, target *domain.Target) error {
 	mission, err := s.missionRepo.GetMissionByID(ctx, missionID)
 	if err != nil {
 		return err
 	}
-	if mission.Completed {
-		return fmt.Errorf("cannot add a target to a completed mission")
+	if mission.Status.IsTerminal() {
+		return fmt.Errorf("cannot add a target to a mission that has ended")
 	}
 	if len(mission.Targets) >= 3 {
 		return fmt.Errorf("a mission cannot have more than 3 targets")
 	}
 	target.MissionID = missionID
-	return s.targetRepo.AddTargetToMission(ctx, target)
+	return s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.targetRepo.AddTargetToMission(ctx, target); err != nil {
+			return err
+		}
+		return recordEvent(ctx, s.eventRepo, missionID, domain.MissionEventTargetAdded, map[string]any{
+			"target_id": target.ID,
+			"name":      target.Name,
+			"country":   target.Country,
+		})
+	})
 }
 
-// UpdateTargetNotes updates the notes of a target if it and its mission are not complete.
+// UpdateTargetNotes updates the notes of a target if it is not complete and its mission hasn't ended.
 func (s *targetService) UpdateTargetNotes(ctx context.Context, targetID int, notes string) (*domain.Target, error) {
 	target, err := s.targetRepo.GetTargetByID(ctx, targetID)
 	if err != nil {
@@ -51,53 +64,106 @@ func (s *targetService) UpdateTargetNotes(ctx context.Context, targetID int, not
 	if err != nil {
 		return nil, err
 	}
-	if mission.Completed {
-		return nil, fmt.Errorf("cannot update notes on a target in a completed mission")
+	if mission.Status.IsTerminal() {
+		return nil, fmt.Errorf("cannot update notes on a target in a mission that has ended")
 	}
 
+	previous := target.Notes
 	target.Notes = notes
-	if err := s.targetRepo.UpdateTarget(ctx, target); err != nil {
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.targetRepo.UpdateTarget(ctx, target); err != nil {
+			return err
+		}
+		return recordEvent(ctx, s.eventRepo, target.MissionID, domain.MissionEventTargetNotesUpdated, map[string]any{
+			"target_id":      target.ID,
+			"notes":          notes,
+			"previous_notes": previous,
+		})
+	})
+	if err != nil {
 		return nil, err
 	}
 	return target, nil
 }
 
-// CompleteTarget marks a target as complete and checks if the entire mission is now complete.
+// CompleteTarget marks a target as complete, unless it already is. The first completed
+// target starts an assigned mission, provided its prerequisites are completed, and
+// completing the last one completes the mission.
 func (s *targetService) CompleteTarget(ctx context.Context, targetID int) (*domain.Target, error) {
 	target, err := s.targetRepo.GetTargetByID(ctx, targetID)
 	if err != nil {
 		return nil, err
 	}
-	target.Completed = true
-	if err := s.targetRepo.UpdateTarget(ctx, target); err != nil {
-		return nil, err
+	if target.Completed {
+		return nil, fmt.Errorf("target is already completed")
 	}
 
-	// Check if all targets in the mission are now complete
 	mission, err := s.missionRepo.GetMissionByID(ctx, target.MissionID)
 	if err != nil {
-		return nil, err // The target was updated, but we failed to check the mission status
+		return nil, err
+	}
+	switch {
+	case mission.Status == domain.MissionStatusDraft:
+		return nil, fmt.Errorf("cannot complete a target of a mission without an assigned cat")
+	case mission.Status.IsTerminal():
+		return nil, fmt.Errorf("cannot complete a target of a mission that has ended")
+	case mission.Status == domain.MissionStatusAssigned:
+		if err := checkPrerequisites(ctx, s.missionRepo, mission.ID); err != nil {
+			return nil, err
+		}
 	}
 
+	// Check if all targets in the mission are now complete
 	allTargetsComplete := true
 	for _, t := range mission.Targets {
-		if !t.Completed {
+		if !t.Completed && t.ID != target.ID {
 			allTargetsComplete = false
 			break
 		}
 	}
 
-	if allTargetsComplete {
-		mission.Completed = true
-		if err := s.missionRepo.UpdateMission(ctx, mission); err != nil {
-			return target, fmt.Errorf("failed to mark mission as complete: %w", err)
+	target.Completed = true
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.targetRepo.UpdateTarget(ctx, target); err != nil {
+			return err
+		}
+		err := recordEvent(ctx, s.eventRepo, mission.ID, domain.MissionEventTargetCompleted, map[string]any{
+			"target_id": target.ID,
+			"name":      target.Name,
+		})
+		if err != nil {
+			return err
+		}
+
+		if mission.Status == domain.MissionStatusAssigned {
+			mission.Status = domain.MissionStatusInProgress
+			if err := s.missionRepo.UpdateMissionStatus(ctx, mission, domain.MissionStatusAssigned); err != nil {
+				return fmt.Errorf("failed to start mission: %w", err)
+			}
+			if err := statusEvent(ctx, s.missionRepo, s.eventRepo, mission, domain.MissionStatusAssigned); err != nil {
+				return err
+			}
 		}
+
+		if allTargetsComplete {
+			mission.Status = domain.MissionStatusCompleted
+			if err := s.missionRepo.UpdateMissionStatus(ctx, mission, domain.MissionStatusInProgress); err != nil {
+				return fmt.Errorf("failed to mark mission as complete: %w", err)
+			}
+			return statusEvent(ctx, s.missionRepo, s.eventRepo, mission, domain.MissionStatusInProgress)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return target, nil
 }
 
-// DeleteTarget deletes a target if it is not yet completed.
+// DeleteTarget deletes a target if it is not yet completed and its mission hasn't ended.
+// The last open target of a mission in progress can't be deleted, as the mission would
+// be left with only completed targets; it has to be completed instead.
 func (s *targetService) DeleteTarget(ctx context.Context, targetID int) error {
 	target, err := s.targetRepo.GetTargetByID(ctx, targetID)
 	if err != nil {
@@ -106,5 +172,34 @@ func (s *targetService) DeleteTarget(ctx context.Context, targetID int) error {
 	if target.Completed {
 		return fmt.Errorf("cannot delete a completed target")
 	}
-	return s.targetRepo.DeleteTarget(ctx, targetID)
+
+	mission, err := s.missionRepo.GetMissionByID(ctx, target.MissionID)
+	if err != nil {
+		return err
+	}
+	if mission.Status.IsTerminal() {
+		return fmt.Errorf("cannot delete a target of a mission that has ended")
+	}
+	if mission.Status == domain.MissionStatusInProgress {
+		lastOpen := true
+		for _, t := range mission.Targets {
+			if !t.Completed && t.ID != target.ID {
+				lastOpen = false
+				break
+			}
+		}
+		if lastOpen {
+			return fmt.Errorf("cannot delete the last open target of a mission in progress, complete it instead")
+		}
+	}
+
+	return s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.targetRepo.DeleteTarget(ctx, targetID); err != nil {
+			return err
+		}
+		return recordEvent(ctx, s.eventRepo, target.MissionID, domain.MissionEventTargetDeleted, map[string]any{
+			"target_id": target.ID,
+			"name":      target.Name,
+		})
+	})
 }
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"spy_cats_agency/internal/domain"
+	"spy_cats_agency/internal/repository"
+)
+
+// systemActor is recorded for changes made without an actor, such as by background jobs.
+const systemActor = "system"
+
+type actorKey struct{}
+
+// WithActor returns a context that attributes the changes made with it to actor.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// actorFrom returns who makes the changes with ctx.
+func actorFrom(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey{}).(string); ok && actor != "" {
+		return actor
+	}
+	return systemActor
+}
+
+// recordEvent adds an event to the timeline of a mission, attributed to the actor of ctx.
+// Called within a transaction, the event is only kept if the change it records is.
+func recordEvent(ctx context.Context, eventRepo repository.MissionEventRepository, missionID int, kind domain.MissionEventKind, payload map[string]any) error {
+	if payload == nil {
+		payload = map[string]any{}
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	return eventRepo.CreateEvent(ctx, &domain.MissionEvent{
+		MissionID: missionID,
+		Kind:      kind,
+		Actor:     actorFrom(ctx),
+		Payload:   data,
+	})
+}
+
+// releasedTeamEvent records that every cat released from the team of a mission by the
+// change just made to it, within the same transaction, left the team. Those are the cats
+// that left at the time of the change, or that are still on the team of a mission that
+// ended and stay on record as its final team.
+func releasedTeamEvent(ctx context.Context, missionRepo repository.MissionRepository, eventRepo repository.MissionEventRepository, mission *domain.Mission, reason string) error {
+	team, err := missionRepo.ListTeamHistory(ctx, mission.ID)
+	if err != nil {
+		return err
+	}
+	for _, member := range team {
+		if member.LeftAt != nil && !member.LeftAt.Equal(mission.UpdatedAt) {
+			continue
+		}
+		err := recordEvent(ctx, eventRepo, mission.ID, domain.MissionEventCatUnassigned, map[string]any{
+			"cat_id": member.CatID,
+			"role":   member.Role,
+			"reason": reason,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// statusEvent records a change of a mission's status. Completion gets its own event kind.
+// A mission that ended has released its team, which is recorded for every member.
+func statusEvent(ctx context.Context, missionRepo repository.MissionRepository, eventRepo repository.MissionEventRepository, mission *domain.Mission, from domain.MissionStatus) error {
+	var err error
+	if mission.Status == domain.MissionStatusCompleted {
+		err = recordEvent(ctx, eventRepo, mission.ID, domain.MissionEventCompleted, nil)
+	} else {
+		err = recordEvent(ctx, eventRepo, mission.ID, domain.MissionEventStatusChanged, map[string]any{"from": from, "to": mission.Status})
+	}
+	if err != nil || !mission.Status.IsTerminal() {
+		return err
+	}
+	return releasedTeamEvent(ctx, missionRepo, eventRepo, mission, "mission_ended")
+}
@@ -4,19 +4,56 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 )
 
 // Breed represents a cat breed from TheCatAPI.
 type Breed struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
+	ID               string `json:"id"`
+	Name             string `json:"name"`
+	Description      string `json:"description"`
+	Temperament      string `json:"temperament"`
+	Origin           string `json:"origin"`
+	CountryCode      string `json:"country_code"`
+	LifeSpan         string `json:"life_span"` // In years, e.g. "14 - 15"
+	Weight           Weight `json:"weight"`
+	AltNames         string `json:"alt_names"`
+	WikipediaURL     string `json:"wikipedia_url"`
+	ReferenceImageID string `json:"reference_image_id"`
+	Image            *Image `json:"image,omitempty"`
+
+	// Traits rated from 1 to 5
+	Adaptability     int `json:"adaptability"`
+	AffectionLevel   int `json:"affection_level"`
+	EnergyLevel      int `json:"energy_level"`
+	Intelligence     int `json:"intelligence"`
+	SocialNeeds      int `json:"social_needs"`
+	StrangerFriendly int `json:"stranger_friendly"`
+	Vocalisation     int `json:"vocalisation"`
+}
+
+// Weight is the typical weight range of a breed.
+type Weight struct {
+	Imperial string `json:"imperial"` // In pounds
+	Metric   string `json:"metric"`   // In kilograms
+}
+
+// Image is a cat picture hosted by TheCatAPI.
+type Image struct {
+	ID     string `json:"id"`
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
 }
 
 // Client is a client for TheCatAPI.
 type Client struct {
 	BaseURL    string
+	APIKey     string // Optional, lifts the limits of anonymous requests
 	HTTPClient *http.Client
 	breeds     []Breed
 	mu         sync.RWMutex
@@ -24,9 +61,10 @@ type Client struct {
 }
 
 // NewClient creates a new CatAPI client.
-func NewClient(baseURL string) *Client {
+func NewClient(baseURL, apiKey string) *Client {
 	return &Client{
 		BaseURL: baseURL,
+		APIKey:  apiKey,
 		HTTPClient: &http.Client{
 			Timeout: 10 * time.Second,
 		},
@@ -46,24 +84,9 @@ func (c *Client) GetBreeds() ([]Breed, error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/breeds", c.BaseURL), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to fetch breeds: received status code %d", resp.StatusCode)
-	}
-
 	var breeds []Breed
-	if err := json.NewDecoder(resp.Body).Decode(&breeds); err != nil {
-		return nil, err
+	if err := c.get("/breeds", nil, &breeds); err != nil {
+		return nil, fmt.Errorf("failed to fetch breeds: %w", err)
 	}
 
 	c.breeds = breeds
@@ -74,16 +97,66 @@ func (c *Client) GetBreeds() ([]Breed, error) {
 
 // IsValidBreed checks if a given breed name is valid.
 func (c *Client) IsValidBreed(breedName string) (bool, error) {
-	breeds, err := c.GetBreeds()
+	breed, err := c.GetBreedByName(breedName)
 	if err != nil {
 		return false, err
 	}
+	return breed != nil, nil
+}
+
+// GetBreedByName looks up a breed by its exact name. It returns nil if there is no such breed.
+func (c *Client) GetBreedByName(breedName string) (*Breed, error) {
+	breeds, err := c.GetBreeds()
+	if err != nil {
+		return nil, err
+	}
 
-	for _, b := range breeds {
-		if b.Name == breedName {
-			return true, nil
+	for i := range breeds {
+		if breeds[i].Name == breedName {
+			return &breeds[i], nil
 		}
 	}
 
-	return false, nil
+	return nil, nil
+}
+
+// SearchBreedImages fetches up to limit images of the breed with the given ID.
+func (c *Client) SearchBreedImages(breedID string, limit int) ([]Image, error) {
+	query := url.Values{}
+	query.Set("breed_ids", breedID)
+	query.Set("limit", strconv.Itoa(limit))
+
+	var images []Image
+	if err := c.get("/images/search", query, &images); err != nil {
+		return nil, fmt.Errorf("failed to fetch breed images: %w", err)
+	}
+	return images, nil
+}
+
+// get performs a GET request against the API and decodes the JSON response into out.
+func (c *Client) get(path string, query url.Values, out interface{}) error {
+	endpoint := strings.TrimSuffix(c.BaseURL, "/") + path
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return err
+	}
+	if c.APIKey != "" {
+		req.Header.Set("x-api-key", c.APIKey)
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("received status code %d", resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
 }